@@ -1,8 +1,16 @@
 package validate
 
+import "github.com/tonyhb/govalidate/rules"
+
 type ValidationError struct {
 	Failures []string
 
+	// The errors returned by each failing rule, in the same order as Failures.
+	// These are usually rules.ErrInvalid, or a type embedding it such as
+	// rules.ErrCount, and can be type asserted to get structured details about
+	// the failure.
+	Errors []error
+
 	// Stores a list of fields that failed validation. This is useful
 	// during testing: you can assert that all validation rules are
 	// working as expected.
@@ -22,8 +30,17 @@ type ValidationError struct {
 	Fields map[string]struct{}
 }
 
-func (ve *ValidationError) addFailure(field, msg string) {
-	ve.Failures = append(ve.Failures, msg)
+func (ve *ValidationError) addFailure(field string, err error) {
+	// Rules checking several requirements return an error for each
+	if errs, ok := err.(rules.ErrMultiple); ok {
+		for _, err := range errs {
+			ve.addFailure(field, err)
+		}
+		return
+	}
+
+	ve.Failures = append(ve.Failures, err.Error())
+	ve.Errors = append(ve.Errors, err)
 
 	// Ensure we're not assigning to a nil map
 	if ve.Fields == nil {
@@ -51,6 +68,9 @@ func (ve *ValidationError) Merge(other ValidationError) {
 	for _, v := range other.Failures {
 		ve.Failures = append(ve.Failures, v)
 	}
+	for _, v := range other.Errors {
+		ve.Errors = append(ve.Errors, v)
+	}
 	for f, v := range other.Fields {
 		if ve.Fields == nil {
 			ve.Fields = map[string]struct{}{}
@@ -0,0 +1,126 @@
+package helper
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Returns the number of runes (Unicode code points) in a string.
+func RuneCount(str string) int {
+	return utf8.RuneCountInString(str)
+}
+
+// Returns the number of user-perceived characters in a string, following the
+// extended grapheme cluster rules of Unicode TR29 closely enough for
+// validating lengths. This means:
+//
+//   - combining marks, variation selectors and emoji modifiers are counted
+//     with the character they modify, so "é" is one character whether or not
+//     it is composed
+//   - emoji joined with a zero width joiner count as one character
+//   - pairs of regional indicators (flags) count as one character
+//   - Hangul syllables made of individual jamo count as one character
+//   - "\r\n" counts as one character
+//
+// Prepended concatenation marks, which are only used by a handful of scripts,
+// are counted separately.
+func GraphemeCount(str string) int {
+	var (
+		count int
+		prev  rune
+		ri    int // The number of consecutive regional indicators before r
+	)
+
+	for i, r := range str {
+		if i == 0 || isGraphemeBoundary(prev, r, ri) {
+			count++
+		}
+
+		if isRegionalIndicator(r) {
+			ri++
+		} else {
+			ri = 0
+		}
+		prev = r
+	}
+
+	return count
+}
+
+// Reports whether there is a grapheme cluster boundary between prev and r.
+func isGraphemeBoundary(prev, r rune, ri int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return false
+	case isGraphemeControl(prev) || isGraphemeControl(r):
+		return true
+	case isHangulL(prev) && (isHangulL(r) || isHangulV(r) || isHangulLV(r) || isHangulLVT(r)):
+		return false
+	case (isHangulLV(prev) || isHangulV(prev)) && (isHangulV(r) || isHangulT(r)):
+		return false
+	case (isHangulLVT(prev) || isHangulT(prev)) && isHangulT(r):
+		return false
+	case isGraphemeExtend(r) || r == zwj:
+		return false
+	case prev == zwj && isPictographic(r):
+		return false
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		// Regional indicators pair up: only break when the preceding run has
+		// an even number of indicators.
+		return ri%2 == 0
+	}
+	return true
+}
+
+const zwj = '\u200d' // Zero width joiner
+
+func isGraphemeControl(r rune) bool {
+	if r == zwj || r == '\u200c' {
+		return false
+	}
+	return unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp) ||
+		(unicode.Is(unicode.Cf, r) && !isEmojiTag(r))
+}
+
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == '\u200c' ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // Emoji skin tone modifiers
+		isEmojiTag(r)
+}
+
+func isEmojiTag(r rune) bool {
+	return r >= 0xE0020 && r <= 0xE007F
+}
+
+func isPictographic(r rune) bool {
+	return unicode.Is(unicode.So, r) ||
+		(r >= 0x1F000 && r <= 0x1FAFF) ||
+		(r >= 0x2600 && r <= 0x27BF)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+func isHangulL(r rune) bool {
+	return (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C)
+}
+
+func isHangulV(r rune) bool {
+	return (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6)
+}
+
+func isHangulT(r rune) bool {
+	return (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB)
+}
+
+// Precomposed syllables without a trailing consonant
+func isHangulLV(r rune) bool {
+	return r >= 0xAC00 && r <= 0xD7A3 && (r-0xAC00)%28 == 0
+}
+
+// Precomposed syllables with a trailing consonant
+func isHangulLVT(r rune) bool {
+	return r >= 0xAC00 && r <= 0xD7A3 && (r-0xAC00)%28 != 0
+}
@@ -0,0 +1,33 @@
+package helper
+
+import "testing"
+
+func TestGraphemeCount(t *testing.T) {
+	var tests = []struct {
+		Value     string
+		Runes     int
+		Graphemes int
+	}{
+		{"", 0, 0},
+		{"cafe", 4, 4},
+		{"café", 4, 4},
+		{"cafe\u0301", 5, 4},
+		{"\r\n", 2, 1},
+		{"👍🏽", 2, 1},
+		{"👩\u200d👩\u200d👧", 5, 1},
+		{"🇬🇧🇫🇷", 4, 2},
+		{"🇬🇧🇫", 3, 2},
+		{"\u1100\u1161\u11a8", 3, 1},
+		{"한국어", 3, 3},
+		{"❤\ufe0f", 2, 1},
+	}
+
+	for _, v := range tests {
+		if n := RuneCount(v.Value); n != v.Runes {
+			t.Errorf("Expected %q to have %d runes, got %d", v.Value, v.Runes, n)
+		}
+		if n := GraphemeCount(v.Value); n != v.Graphemes {
+			t.Errorf("Expected %q to have %d graphemes, got %d", v.Value, v.Graphemes, n)
+		}
+	}
+}
@@ -1,61 +1,166 @@
 package helper
 
-import "errors"
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+)
 
+// Returns true if the data is any unsigned integer kind, including named types
+// such as `type Count uint16`.
 func IsUint(data interface{}) bool {
-	switch data.(type) {
-	case uint64, uint32, uint16, uint8, uint:
+	switch reflect.ValueOf(data).Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 		return true
 	}
 	return false
 }
 
+// Returns true if the data is any signed integer kind, including named types
+// such as `type Age int`.
+func IsInt(data interface{}) bool {
+	switch reflect.ValueOf(data).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// Returns true if the data is any int, uint or float kind, a json.Number or
+// a non-nil *big.Int or *big.Float.
+func IsNumeric(data interface{}) bool {
+	_, err := ToBigFloat(data)
+	return err == nil
+}
+
+// Returns the number of items in a slice, array or map.
+func ToLen(data interface{}) (int, error) {
+	value := reflect.ValueOf(data)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return value.Len(), nil
+	}
+	return 0, errors.New("Invalid conversion to a collection")
+}
+
 func ToUint64(data interface{}) (uint64, error) {
-	switch data.(type) {
-	case uint64:
-		return data.(uint64), nil
-	case uint32:
-		return uint64(data.(int32)), nil
-	case uint16:
-		return uint64(data.(int16)), nil
-	case uint8:
-		return uint64(data.(int8)), nil
-	case uint:
-		return uint64(data.(int)), nil
+	if IsUint(data) {
+		return reflect.ValueOf(data).Uint(), nil
 	}
 	return 0, errors.New("Invalid conversion to uint64")
 }
 
-// Helper method, converting all int and float types in an interface to a float64.
+// Helper method, converting all int, uint and float types in an interface to
+// a float64. Note that int64 and uint64 values above 2^53 lose precision; use
+// ToBigFloat when comparing numbers.
 func ToFloat64(data interface{}) (float64, error) {
-	switch data.(type) {
-	case float64:
-		return data.(float64), nil
-	case float32:
-		return float64(data.(float32)), nil
-	case int64:
-		return float64(data.(int64)), nil
-	case int32:
-		return float64(data.(int32)), nil
-	case int16:
-		return float64(data.(int16)), nil
-	case int8:
-		return float64(data.(int8)), nil
-	case int:
-		return float64(data.(int)), nil
+	switch v := data.(type) {
+	case json.Number:
+		return v.Float64()
+	case *big.Int, *big.Float:
+		f, err := ToBigFloat(data)
+		if err != nil {
+			return 0, err
+		}
+		result, _ := f.Float64()
+		return result, nil
+	}
+
+	value := reflect.ValueOf(data)
+	switch value.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(value.Uint()), nil
 	}
 	return 0, errors.New("Invalid conversion to float64")
 }
 
+// Converts a value into a string. Conversions are tried in the following order,
+// using the first that applies:
+//
+//  1. any type whose kind is a string or a slice of runes (such as
+//     `type Slug string`), using its underlying value
+//  2. types implementing encoding.TextMarshaler (such as net.IP)
+//  3. any other type whose kind is a slice of bytes
+//  4. types implementing fmt.Stringer
+//
+// A named string type is converted to its value rather than its String(),
+// which is often for display. TextMarshaler takes precedence over Stringer as
+// it is intended to be the canonical machine readable form, and over the
+// underlying bytes so that binary types such as net.IP convert to text.
+//
+// time.Time and time.Duration aren't strings, so they're never converted;
+// use the time rules for them. Nil pointers are never converted.
 func ToString(data interface{}) (string, error) {
-	switch data.(type) {
+	switch v := data.(type) {
 	case string:
-		return data.(string), nil
+		return v, nil
 	case []byte:
-		return string(data.([]byte)), nil
+		return string(v), nil
 	case []rune:
-		return string(data.([]rune)), nil
+		return string(v), nil
+	case time.Time, *time.Time, time.Duration:
+		return "", errors.New("Invalid conversion to string")
+	}
+
+	value := reflect.ValueOf(data)
+	if !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return "", errors.New("Invalid conversion to string")
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Int32 {
+			return string(value.Convert(reflect.TypeOf([]rune{})).Interface().([]rune)), nil
+		}
+	}
+
+	if v, ok := data.(encoding.TextMarshaler); ok {
+		text, err := v.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+		return string(value.Bytes()), nil
+	}
+
+	if v, ok := data.(fmt.Stringer); ok {
+		return v.String(), nil
 	}
 
 	return "", errors.New("Invalid conversion to string")
 }
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Converts a time.Time, a non-nil *time.Time or a named type based on
+// time.Time into a time.Time.
+func ToTime(data interface{}) (time.Time, error) {
+	switch v := data.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v != nil {
+			return *v, nil
+		}
+		return time.Time{}, errors.New("Invalid conversion from nil *time.Time")
+	}
+
+	value := reflect.ValueOf(data)
+	if value.IsValid() && value.Type().ConvertibleTo(timeType) {
+		return value.Convert(timeType).Interface().(time.Time), nil
+	}
+	return time.Time{}, errors.New("Invalid conversion to time.Time")
+}
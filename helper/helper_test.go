@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+type slug string
+type bytes []byte
+type id int
+
+func (i id) String() string {
+	return "id-1"
+}
+
+// Implements both TextMarshaler and Stringer; TextMarshaler must win.
+type both struct{}
+
+func (both) MarshalText() ([]byte, error) {
+	return []byte("text"), nil
+}
+
+func (both) String() string {
+	return "stringer"
+}
+
+// A named string type with a String method should use its value
+type status string
+
+func (s status) String() string {
+	return "Status: " + string(s)
+}
+
+func TestToString(t *testing.T) {
+	var valid = []struct {
+		Value    interface{}
+		Expected string
+	}{
+		{"foo", "foo"},
+		{[]byte("foo"), "foo"},
+		{[]rune("foo"), "foo"},
+		{slug("foo"), "foo"},
+		{bytes("foo"), "foo"},
+		{net.ParseIP("127.0.0.1"), "127.0.0.1"},
+		{id(1), "id-1"},
+		{both{}, "text"},
+		{status("draft"), "draft"},
+	}
+
+	for _, v := range valid {
+		str, err := ToString(v.Value)
+		if err != nil {
+			t.Errorf("Unexpected error converting %#v: %s", v.Value, err)
+		}
+		if str != v.Expected {
+			t.Errorf("Expected %#v to convert to '%s', got '%s'", v.Value, v.Expected, str)
+		}
+	}
+
+	var invalid = []interface{}{
+		1,
+		'a',
+		struct{}{},
+		[]int{1},
+		(*both)(nil),
+		nil,
+		time.Time{},
+		&time.Time{},
+		time.Hour,
+	}
+
+	for _, v := range invalid {
+		if _, err := ToString(v); err == nil {
+			t.Errorf("Expected error converting %#v", v)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package helper
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Converts a host name into its ASCII form. Each label which contains Unicode
+// is lower-cased and punycode encoded with an "xn--" prefix, so "Bücher.de"
+// becomes "xn--bcher-kva.de". ASCII labels are lower-cased.
+//
+// Unicode labels may only contain letters, marks, digits and hyphens. Labels
+// starting with "xn--" must be valid punycode.
+//
+// This is a simplified form of IDNA: it doesn't apply Unicode normalization
+// or the IDNA2008 contextual rules.
+func ToASCIIHostname(str string) (string, error) {
+	// IDNA treats these as dots in addition to "."
+	str = strings.NewReplacer("。", ".", "．", ".", "｡", ".").Replace(str)
+
+	labels := strings.Split(str, ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			label = strings.ToLower(label)
+			if strings.HasPrefix(label, "xn--") {
+				if _, err := toUnicodeLabel(label); err != nil {
+					return "", err
+				}
+			}
+			labels[i] = label
+			continue
+		}
+
+		for _, r := range label {
+			if !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r) && r != '-' {
+				return "", errors.New("Invalid character in host name")
+			}
+		}
+
+		encoded, err := PunycodeEncode(strings.ToLower(label))
+		if err != nil {
+			return "", err
+		}
+		labels[i] = "xn--" + encoded
+	}
+
+	return strings.Join(labels, "."), nil
+}
+
+// Converts a host name into its Unicode form, decoding any "xn--" labels.
+func ToUnicodeHostname(str string) (string, error) {
+	labels := strings.Split(str, ".")
+	for i, label := range labels {
+		decoded, err := toUnicodeLabel(strings.ToLower(label))
+		if err != nil {
+			return "", err
+		}
+		labels[i] = decoded
+	}
+	return strings.Join(labels, "."), nil
+}
+
+func toUnicodeLabel(label string) (string, error) {
+	if !strings.HasPrefix(label, "xn--") {
+		return label, nil
+	}
+
+	decoded, err := PunycodeDecode(label[4:])
+	if err != nil {
+		return "", err
+	}
+
+	// Encoding must round trip, otherwise the label isn't canonical (for
+	// example "xn--abc-" which decodes to pure ASCII).
+	if isASCII(decoded) {
+		return "", errors.New("Invalid punycode label")
+	}
+	if encoded, err := PunycodeEncode(decoded); err != nil || encoded != label[4:] {
+		return "", errors.New("Invalid punycode label")
+	}
+	return decoded, nil
+}
+
+// Reports whether str is a valid host name as defined by RFC 1123: one or more
+// dot separated labels of 1-63 letters, digits and hyphens, which don't start
+// or end with a hyphen, and at most 253 characters in total. A single trailing
+// dot (for the DNS root) is allowed.
+//
+// Internationalized names must be converted using ToASCIIHostname first.
+func IsHostname(str string) bool {
+	str = strings.TrimSuffix(str, ".")
+	if str == "" || len(str) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(str, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
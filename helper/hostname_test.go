@@ -0,0 +1,66 @@
+package helper
+
+import "testing"
+
+func TestPunycode(t *testing.T) {
+	var tests = map[string]string{
+		"bücher":  "bcher-kva",
+		"münchen": "mnchen-3ya",
+		"例え":      "r8jz45g",
+		"правда":  "80aafi6cg",
+		"☃":       "n3h",
+		"日本語":     "wgv71a119e",
+		"mañana":  "maana-pta",
+	}
+
+	for unicode, ascii := range tests {
+		if encoded, err := PunycodeEncode(unicode); err != nil || encoded != ascii {
+			t.Errorf("Expected %s to encode to %s, got %s (%v)", unicode, ascii, encoded, err)
+		}
+		if decoded, err := PunycodeDecode(ascii); err != nil || decoded != unicode {
+			t.Errorf("Expected %s to decode to %s, got %s (%v)", ascii, unicode, decoded, err)
+		}
+	}
+
+	for _, invalid := range []string{"!!", "bcher-kv", "99999999999"} {
+		if _, err := PunycodeDecode(invalid); err == nil {
+			t.Errorf("Expected %s to fail decoding", invalid)
+		}
+	}
+}
+
+func TestHostname(t *testing.T) {
+	var ascii = map[string]string{
+		"Example.COM":      "example.com",
+		"Bücher.de":        "xn--bcher-kva.de",
+		"münchen.de.":      "xn--mnchen-3ya.de.",
+		"例え。テスト":           "xn--r8jz45g.xn--zckzah",
+		"xn--bcher-kva.de": "xn--bcher-kva.de",
+	}
+
+	for in, expected := range ascii {
+		out, err := ToASCIIHostname(in)
+		if err != nil || out != expected {
+			t.Errorf("Expected %s to convert to %s, got %s (%v)", in, expected, out, err)
+		}
+		if !IsHostname(out) {
+			t.Errorf("Expected %s to be a valid hostname", out)
+		}
+	}
+
+	for _, invalid := range []string{"xn--abc-.com", "xn--zz.com", "exa☃mple.com", "ex ample.com"} {
+		if out, err := ToASCIIHostname(invalid); err == nil && IsHostname(out) {
+			t.Errorf("Expected %s to be an invalid hostname", invalid)
+		}
+	}
+
+	if unicode, err := ToUnicodeHostname("xn--bcher-kva.de"); err != nil || unicode != "bücher.de" {
+		t.Errorf("Unexpected Unicode hostname %s (%v)", unicode, err)
+	}
+
+	for _, invalid := range []string{"", ".", "-a.com", "a-.com", "a..com", "a_b.com", string(make([]byte, 64)) + ".com"} {
+		if IsHostname(invalid) {
+			t.Errorf("Expected %q to be an invalid hostname", invalid)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Converts any numeric value into a *big.Float without losing precision.
+//
+// Integers (of any size, including *big.Int and integral json.Numbers) are
+// stored exactly, so comparing two big int64 or uint64 values gives the correct
+// answer even when they can't be represented as a float64. Floats are stored
+// with their original float64 precision.
+//
+// This accepts every int, uint and float kind (including named types such as
+// `type Age int`), json.Number, *big.Int and *big.Float. NaN is rejected as it
+// can't be compared.
+func ToBigFloat(data interface{}) (*big.Float, error) {
+	switch v := data.(type) {
+	case json.Number:
+		return ParseNumber(string(v))
+	case *big.Int:
+		if v == nil {
+			return nil, errors.New("Invalid conversion from nil *big.Int")
+		}
+		return new(big.Float).SetInt(v), nil
+	case *big.Float:
+		if v == nil {
+			return nil, errors.New("Invalid conversion from nil *big.Float")
+		}
+		return new(big.Float).Copy(v), nil
+	}
+
+	value := reflect.ValueOf(data)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(value.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(value.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f := value.Float()
+		if math.IsNaN(f) {
+			return nil, errors.New("Invalid conversion from NaN")
+		}
+		return big.NewFloat(f), nil
+	}
+
+	return nil, errors.New("Invalid conversion to number")
+}
+
+// Parses a number from a string, such as the argument to a validation tag.
+// Integers are parsed exactly whatever their size; anything else is parsed as
+// a float64 so that it compares equally with float64 fields holding the same
+// literal.
+func ParseNumber(str string) (*big.Float, error) {
+	str = strings.TrimSpace(str)
+
+	if i, ok := new(big.Int).SetString(str, 10); ok {
+		return new(big.Float).SetInt(i), nil
+	}
+
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(f) {
+		return nil, errors.New("Invalid number NaN")
+	}
+	return big.NewFloat(f), nil
+}
@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+)
+
+type age int
+type count uint16
+
+func TestToBigFloat(t *testing.T) {
+	var valid = []interface{}{
+		1,
+		int8(-1),
+		uint(1),
+		uint32(1),
+		uint64(math.MaxUint64),
+		float32(1.5),
+		age(30),
+		count(3),
+		json.Number("12"),
+		json.Number("1.5e3"),
+		big.NewInt(5),
+		big.NewFloat(2.5),
+	}
+	var invalid = []interface{}{
+		"1",
+		struct{}{},
+		math.NaN(),
+		json.Number("foo"),
+		(*big.Int)(nil),
+	}
+
+	for _, v := range valid {
+		if _, err := ToBigFloat(v); err != nil {
+			t.Errorf("Unexpected error converting %#v: %s", v, err)
+		}
+	}
+
+	for _, v := range invalid {
+		if _, err := ToBigFloat(v); err == nil {
+			t.Errorf("Expected error converting %#v", v)
+		}
+	}
+}
+
+// Large 64 bit integers must be compared exactly rather than via float64,
+// which can't tell 2^63-1 and 2^63-2 apart.
+func TestToBigFloatPrecision(t *testing.T) {
+	a, _ := ToBigFloat(int64(math.MaxInt64))
+	b, _ := ParseNumber("9223372036854775806")
+	if a.Cmp(b) != 1 {
+		t.Errorf("Expected MaxInt64 to be greater than MaxInt64-1")
+	}
+
+	c, _ := ToBigFloat(uint64(math.MaxUint64))
+	d, _ := ParseNumber("18446744073709551615")
+	if c.Cmp(d) != 0 {
+		t.Errorf("Expected MaxUint64 to equal its literal")
+	}
+}
+
+func TestToUint64(t *testing.T) {
+	for _, v := range []interface{}{uint(7), uint8(7), uint16(7), uint32(7), uint64(7), count(7)} {
+		if n, err := ToUint64(v); err != nil || n != 7 {
+			t.Errorf("Unexpected result converting %#v: %d, %v", v, n, err)
+		}
+	}
+
+	if _, err := ToUint64(7); err == nil {
+		t.Errorf("Expected error converting a signed int")
+	}
+}
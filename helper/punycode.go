@@ -0,0 +1,190 @@
+package helper
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// Punycode parameters from RFC 3492 section 5
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+var errPunycode = errors.New("Invalid punycode")
+
+// Encodes a string of Unicode characters as punycode, as used for
+// internationalized domain name labels (without the "xn--" prefix).
+func PunycodeEncode(str string) (string, error) {
+	runes := []rune(str)
+
+	var out []byte
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			out = append(out, byte(r))
+		}
+	}
+
+	b := int32(len(out))
+	h := b
+	if b > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := int32(punyInitialN), int32(0), int32(punyInitialBias)
+	for h < int32(len(runes)) {
+		m := int32(math.MaxInt32)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+
+		if int64(delta)+int64(m-n)*int64(h+1) > math.MaxInt32 {
+			return "", errPunycode
+		}
+		delta += (m - n) * (h + 1)
+		n = m
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := int32(punyBase); ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out = append(out, punyEncodeDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyEncodeDigit(q))
+
+			bias = punyAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+
+		delta++
+		n++
+	}
+
+	return string(out), nil
+}
+
+// Decodes a punycode string (without the "xn--" prefix) into Unicode.
+func PunycodeDecode(str string) (string, error) {
+	var output []rune
+
+	pos := 0
+	if i := strings.LastIndex(str, "-"); i >= 0 {
+		for _, c := range str[:i] {
+			if c >= utf8.RuneSelf {
+				return "", errPunycode
+			}
+			output = append(output, c)
+		}
+		pos = i + 1
+	}
+
+	n, i, bias := int32(punyInitialN), int32(0), int32(punyInitialBias)
+	for pos < len(str) {
+		oldi, w := i, int32(1)
+		for k := int32(punyBase); ; k += punyBase {
+			if pos >= len(str) {
+				return "", errPunycode
+			}
+			digit, ok := punyDecodeDigit(str[pos])
+			pos++
+			if !ok || digit > (math.MaxInt32-i)/w {
+				return "", errPunycode
+			}
+			i += digit * w
+
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > math.MaxInt32/(punyBase-t) {
+				return "", errPunycode
+			}
+			w *= punyBase - t
+		}
+
+		x := int32(len(output) + 1)
+		bias = punyAdapt(i-oldi, x, oldi == 0)
+		if i/x > math.MaxInt32-n {
+			return "", errPunycode
+		}
+		n += i / x
+		i %= x
+
+		if n > utf8.MaxRune || (n >= 0xD800 && n <= 0xDFFF) {
+			return "", errPunycode
+		}
+
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = n
+		i++
+	}
+
+	return string(output), nil
+}
+
+func punyThreshold(k, bias int32) int32 {
+	switch {
+	case k <= bias:
+		return punyTMin
+	case k >= bias+punyTMax:
+		return punyTMax
+	}
+	return k - bias
+}
+
+func punyAdapt(delta, numPoints int32, firstTime bool) int32 {
+	if firstTime {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+
+	k := int32(0)
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyEncodeDigit(d int32) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyDecodeDigit(c byte) (int32, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int32(c-'0') + 26, true
+	case c >= 'a' && c <= 'z':
+		return int32(c - 'a'), true
+	case c >= 'A' && c <= 'Z':
+		return int32(c - 'A'), true
+	}
+	return 0, false
+}
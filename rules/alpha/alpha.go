@@ -11,7 +11,14 @@ func init() {
 	rules.Add("Alpha", Alpha)
 }
 
-// Validates that a string only contains alphabetic characters
+var (
+	rxUnicode = regexp.MustCompile(`[^\p{L}\p{M}]`)
+	rxASCII   = regexp.MustCompile(`[^a-zA-Z]`)
+)
+
+// Validates that a string only contains alphabetic characters. Any Unicode
+// letters and combining marks are allowed; use 'Alpha:ascii' to only allow
+// A-Z and a-z.
 func Alpha(data rules.ValidationData) (err error) {
 	v, ok := helper.ToString(data.Value)
 	if ok != nil {
@@ -21,7 +28,12 @@ func Alpha(data rules.ValidationData) (err error) {
 		}
 	}
 
-	if regexp.MustCompile(`[^a-zA-Z]+`).MatchString(v) {
+	rx := rxUnicode
+	if len(data.Args) > 0 && data.Args[0] == "ascii" {
+		rx = rxASCII
+	}
+
+	if rx.MatchString(v) {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        "contains non-alphabetic characters",
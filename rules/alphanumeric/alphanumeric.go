@@ -11,7 +11,14 @@ func init() {
 	rules.Add("Alphanumeric", Alphanumeric)
 }
 
-// Validates that a string only contains alphabetic or numeric characters
+var (
+	rxUnicode = regexp.MustCompile(`[^\p{L}\p{M}\p{N}]`)
+	rxASCII   = regexp.MustCompile(`[^a-zA-Z0-9]`)
+)
+
+// Validates that a string only contains alphanumeric characters. Any Unicode
+// letters, combining marks and numbers are allowed; use 'Alphanumeric:ascii'
+// to only allow A-Z, a-z and 0-9.
 func Alphanumeric(data rules.ValidationData) (err error) {
 	v, ok := helper.ToString(data.Value)
 	if ok != nil {
@@ -21,7 +28,12 @@ func Alphanumeric(data rules.ValidationData) (err error) {
 		}
 	}
 
-	if regexp.MustCompile(`[^a-zA-Z0-9]+`).MatchString(v) {
+	rx := rxUnicode
+	if len(data.Args) > 0 && data.Args[0] == "ascii" {
+		rx = rxASCII
+	}
+
+	if rx.MatchString(v) {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        "contains non-alphanumeric characters",
@@ -0,0 +1,116 @@
+package between
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Between", Between)
+}
+
+// Passes if the data is numeric and lies between two numbers. The bounds can be
+// given as 'Between:min,max', which includes both min and max, or in interval
+// notation where '[' and ']' include the bound and '(' and ')' exclude it:
+//
+//	Between:1,10     1 <= v <= 10
+//	Between:[1,10]   1 <= v <= 10
+//	Between:(0,1]    0 <  v <= 1
+//	Between:[0,100)  0 <= v <  100
+//
+// Fails if the data is not numeric or lies outside of the bounds.
+func Between(data rules.ValidationData) error {
+	v, err := helper.ToBigFloat(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not numeric",
+		}
+	}
+
+	// We should always be provided with a range to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'Between:1,10')")
+	}
+
+	r, err := parseRange(data.Args[0])
+	if err != nil {
+		return err
+	}
+
+	if !r.contains(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "must be " + r.String(),
+		}
+	}
+
+	return nil
+}
+
+type bounds struct {
+	min, max               *big.Float
+	minLiteral, maxLiteral string
+	minOpen, maxOpen       bool
+}
+
+func parseRange(arg string) (b bounds, err error) {
+	arg = strings.TrimSpace(arg)
+
+	if strings.HasPrefix(arg, "(") || strings.HasPrefix(arg, "[") {
+		last := arg[len(arg)-1]
+		if last != ')' && last != ']' {
+			return b, fmt.Errorf("Invalid range '%s': missing closing bracket", arg)
+		}
+		b.minOpen = arg[0] == '('
+		b.maxOpen = last == ')'
+		arg = arg[1 : len(arg)-1]
+	}
+
+	parts := strings.Split(arg, ",")
+	if len(parts) != 2 {
+		return b, fmt.Errorf("Invalid range '%s': expected a minimum and maximum", arg)
+	}
+
+	b.minLiteral, b.maxLiteral = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	if b.min, err = helper.ParseNumber(b.minLiteral); err != nil {
+		return b, err
+	}
+	if b.max, err = helper.ParseNumber(b.maxLiteral); err != nil {
+		return b, err
+	}
+	if b.min.Cmp(b.max) > 0 {
+		return b, fmt.Errorf("Invalid range '%s': minimum is greater than maximum", arg)
+	}
+
+	return b, nil
+}
+
+func (b bounds) contains(v *big.Float) bool {
+	if c := v.Cmp(b.min); c < 0 || (c == 0 && b.minOpen) {
+		return false
+	}
+	if c := v.Cmp(b.max); c > 0 || (c == 0 && b.maxOpen) {
+		return false
+	}
+	return true
+}
+
+func (b bounds) String() string {
+	if !b.minOpen && !b.maxOpen {
+		return fmt.Sprintf("between %s and %s inclusive", b.minLiteral, b.maxLiteral)
+	}
+
+	lower, upper := "greater than or equal to", "less than or equal to"
+	if b.minOpen {
+		lower = "greater than"
+	}
+	if b.maxOpen {
+		upper = "less than"
+	}
+	return fmt.Sprintf("%s %s and %s %s", lower, b.minLiteral, upper, b.maxLiteral)
+}
@@ -0,0 +1,49 @@
+package between
+
+import (
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestBetween(t *testing.T) {
+	var tests = []struct {
+		Range   string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{"1,10", []interface{}{1, 5.5, uint8(10)}, []interface{}{0, 10.01, "5"}},
+		{"[1,10]", []interface{}{1, 10}, []interface{}{0.99, 11}},
+		{"(0,1]", []interface{}{0.001, 1}, []interface{}{0, 1.5}},
+		{"[0, 100)", []interface{}{0, 99.999}, []interface{}{-1, 100}},
+		{"-5, 5", []interface{}{-5, 0, 5}, []interface{}{-6, 6}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  []string{test.Range},
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := Between(object); err != nil {
+				t.Errorf("Unexpected error with %v in %s: %s", v, test.Range, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := Between(object); err == nil {
+				t.Errorf("Expected %v to fail validation in %s", v, test.Range)
+			}
+		}
+	}
+
+	for _, invalid := range []string{"1", "10,1", "[1,10", "a,b"} {
+		object := rules.ValidationData{Field: "Test", Value: 1, Args: []string{invalid}}
+		if _, ok := Between(object).(rules.ErrInvalid); ok {
+			t.Errorf("Expected invalid range %s to return a non-validation error", invalid)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Package domain contains rules for validating host names and domain names,
+// including internationalized names in either their Unicode ("bücher.de") or
+// punycode ("xn--bcher-kva.de") forms.
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Hostname", Hostname)
+	rules.Add("FQDN", FQDN)
+	rules.Add("Domain", Domain)
+}
+
+// Passes if the data is a host name as defined by RFC 1123: one or more labels
+// of letters, digits and hyphens, such as "localhost" or "db-1.internal".
+//
+// Each label must be 1-63 characters long and the whole name must be at most
+// 253 characters long once converted to ASCII. Use 'Hostname:ascii' to reject
+// Unicode names.
+func Hostname(data rules.ValidationData) error {
+	_, err := parse(data, "host name", false)
+	return err
+}
+
+// Passes if the data is a fully qualified domain name: a host name with at
+// least two labels whose top level domain isn't numeric, such as
+// "www.example.com" or "example.com." (with the trailing root dot).
+//
+// Takes the same arguments as Domain.
+func FQDN(data rules.ValidationData) error {
+	name, err := parse(data, "fully qualified domain name", true)
+	if err != nil {
+		return err
+	}
+	return checkFQDN(data, name, "fully qualified domain name")
+}
+
+// Passes if the data is a domain name such as "example.com", without a
+// trailing dot, whose top level domain is made of letters or is a punycode
+// IDN TLD.
+//
+// The following arguments can be combined, eg. 'Domain:ascii:suffix':
+//
+//	ascii   rejects Unicode names; punycode is still allowed
+//	suffix  requires the name to end in a known public suffix (such as "com"
+//	        or "co.uk") and to be registrable below it, so "co.uk" fails but
+//	        "example.co.uk" passes
+func Domain(data rules.ValidationData) error {
+	name, err := parse(data, "domain name", true)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasSuffix(name, ".") {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid domain name; it must not end with a dot",
+		}
+	}
+
+	if err := checkFQDN(data, name, "domain name"); err != nil {
+		return err
+	}
+
+	labels := strings.Split(name, ".")
+	tld := labels[len(labels)-1]
+	if !strings.HasPrefix(tld, "xn--") && strings.IndexFunc(tld, isNotLetter) >= 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("has an invalid top level domain '%s'", tld),
+		}
+	}
+
+	return nil
+}
+
+// Converts the data into an ASCII host name, checking the ascii argument and
+// RFC 1123 rules. The suffix argument is only allowed if suffix is true; the
+// caller checks it.
+func parse(data rules.ValidationData, kind string, suffix bool) (string, error) {
+	str, err := helper.ToString(data.Value)
+	if err != nil {
+		return "", rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	for _, arg := range data.SplitArgs() {
+		switch arg {
+		case "ascii":
+			for _, c := range str {
+				if c > 127 {
+					return "", rules.ErrInvalid{
+						ValidationData: data,
+						Failure:        fmt.Sprintf("is not a valid %s; it must only contain ASCII characters", kind),
+					}
+				}
+			}
+		case "suffix":
+			if !suffix {
+				return "", fmt.Errorf("Unknown argument '%s' (eg 'Hostname:ascii')", arg)
+			}
+		default:
+			return "", fmt.Errorf("Unknown argument '%s' (eg 'Domain:ascii:suffix')", arg)
+		}
+	}
+
+	name, err := helper.ToASCIIHostname(str)
+	if err != nil || !helper.IsHostname(name) {
+		return "", rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid " + kind,
+		}
+	}
+
+	return name, nil
+}
+
+func checkFQDN(data rules.ValidationData, name, kind string) error {
+	labels := strings.Split(strings.TrimSuffix(name, "."), ".")
+	if len(labels) < 2 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a valid %s; it must have a top level domain", kind),
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	if strings.IndexFunc(tld, isNotDigit) < 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("has an invalid top level domain '%s'", tld),
+		}
+	}
+
+	for _, arg := range data.SplitArgs() {
+		if arg != "suffix" {
+			continue
+		}
+
+		suffix, listed := PublicSuffix(name)
+		if !listed {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "does not end in a known public suffix",
+			}
+		}
+		if suffix == strings.TrimSuffix(name, ".") {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        fmt.Sprintf("is a public suffix; it must be a domain registered under '%s'", suffix),
+			}
+		}
+	}
+
+	return nil
+}
+
+func isNotLetter(r rune) bool {
+	return !(r >= 'a' && r <= 'z')
+}
+
+func isNotDigit(r rune) bool {
+	return !(r >= '0' && r <= '9')
+}
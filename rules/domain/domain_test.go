@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestDomainRules(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{Hostname, "Hostname", nil, []interface{}{"localhost", "db-1.internal", "Example.com.", "bücher.de", "xn--bcher-kva.de", "1.2.3.4"}, []interface{}{"", "-a", "a_b", "a..b", "exa mple.com", strings.Repeat("a", 64), strings.Repeat("a.", 127) + "aa", 1}},
+		{Hostname, "Hostname", []string{"ascii"}, []interface{}{"xn--bcher-kva.de"}, []interface{}{"bücher.de"}},
+		{FQDN, "FQDN", nil, []interface{}{"example.com", "example.com.", "www.example.co.uk", "例え.テスト"}, []interface{}{"localhost", "1.2.3.4", "example.123"}},
+		{Domain, "Domain", nil, []interface{}{"example.com", "bücher.de", "example.xn--p1ai"}, []interface{}{"example.com.", "localhost", "example.c0m"}},
+		{Domain, "Domain", []string{"suffix"}, []interface{}{"example.com", "example.co.uk", "www.example.co.uk", "user.github.io", "foo.bar.ck", "www.ck", "правда.рф"}, []interface{}{"com", "co.uk", "github.io", "bar.ck", "example.notatld"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %v: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %v to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
+
+func TestDomainArguments(t *testing.T) {
+	var tests = []struct {
+		Rule rules.ValidatorFunc
+		Args []string
+	}{
+		{Hostname, []string{"suffix"}},
+		{Hostname, []string{"ascii:suffix"}},
+		{Domain, []string{"unicode"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{Field: "Test", Value: "example.com", Args: test.Args}
+		err := test.Rule(object)
+		if _, ok := err.(rules.ErrInvalid); ok || err == nil {
+			t.Errorf("Expected a configuration error for arguments %v, got %v", test.Args, err)
+		}
+	}
+}
+
+func TestPublicSuffix(t *testing.T) {
+	var tests = map[string]string{
+		"example.com":          "com",
+		"www.example.co.uk":    "co.uk",
+		"example.com.":         "com",
+		"foo.bar.ck":           "bar.ck",
+		"www.ck":               "ck",
+		"a.b.kawasaki.jp":      "b.kawasaki.jp",
+		"city.kawasaki.jp":     "kawasaki.jp",
+		"user.github.io":       "github.io",
+		"example.unknownlabel": "unknownlabel",
+		"example.xn--p1ai":     "xn--p1ai",
+		"foo.xn--55qx5d.cn":    "xn--55qx5d.cn",
+	}
+
+	for domain, expected := range tests {
+		if suffix, _ := PublicSuffix(domain); suffix != expected {
+			t.Errorf("Expected public suffix of %s to be %s, got %s", domain, expected, suffix)
+		}
+	}
+
+	defer LoadPublicSuffixList(strings.NewReader(embeddedList))
+	if err := LoadPublicSuffixList(strings.NewReader("// Test\nexample\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, listed := PublicSuffix("foo.com"); listed {
+		t.Errorf("Expected LoadPublicSuffixList to replace the embedded list")
+	}
+	if suffix, listed := PublicSuffix("foo.example"); !listed || suffix != "example" {
+		t.Errorf("Expected LoadPublicSuffixList to load the new list")
+	}
+
+	if err := LoadPublicSuffixList(strings.NewReader("公司.cn\n*.個人.hk\n")); err != nil {
+		t.Fatal(err)
+	}
+	if suffix, listed := PublicSuffix("foo.xn--55qx5d.cn"); !listed || suffix != "xn--55qx5d.cn" {
+		t.Errorf("Expected Unicode rules to match punycode names, got %s", suffix)
+	}
+	if suffix, listed := PublicSuffix("foo.bar.xn--gmqw5a.hk"); !listed || suffix != "bar.xn--gmqw5a.hk" {
+		t.Errorf("Expected Unicode wildcard rules to match punycode names, got %s", suffix)
+	}
+
+	if err := LoadPublicSuffixList(strings.NewReader("com\nbad★.com\n")); err == nil {
+		t.Errorf("Expected an invalid rule to fail loading")
+	}
+}
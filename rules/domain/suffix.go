@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/tonyhb/govalidate/helper"
+)
+
+// A copy of the Public Suffix List. Update it by replacing the file with the
+// latest https://publicsuffix.org/list/public_suffix_list.dat.
+//
+//go:embed public_suffix_list.dat
+var embeddedList string
+
+// The parsed public suffix list
+type suffixList struct {
+	rules      map[string]struct{}
+	wildcards  map[string]struct{}
+	exceptions map[string]struct{}
+}
+
+var (
+	suffixes *suffixList
+	mu       sync.RWMutex
+)
+
+func init() {
+	list, err := parseSuffixList(strings.NewReader(embeddedList))
+	if err != nil {
+		panic(err)
+	}
+	suffixes = list
+}
+
+// Replaces the embedded public suffix list with a list in the format of
+// https://publicsuffix.org/list/public_suffix_list.dat, such as a newer copy.
+// Internationalized suffixes may be written in Unicode or punycode.
+func LoadPublicSuffixList(r io.Reader) error {
+	list, err := parseSuffixList(r)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	suffixes = list
+	mu.Unlock()
+	return nil
+}
+
+func parseSuffixList(r io.Reader) (*suffixList, error) {
+	list := &suffixList{
+		rules:      map[string]struct{}{},
+		wildcards:  map[string]struct{}{},
+		exceptions: map[string]struct{}{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		// Only the first word on each line is significant
+		rule := strings.Fields(line)[0]
+		set := list.rules
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			rule, set = rule[1:], list.exceptions
+		case strings.HasPrefix(rule, "*."):
+			rule, set = rule[2:], list.wildcards
+		}
+
+		// Names are looked up in their ASCII form, but the list writes
+		// internationalized suffixes in Unicode
+		name, err := helper.ToASCIIHostname(rule)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid public suffix list rule '%s': %s", line, err)
+		}
+		set[name] = struct{}{}
+	}
+
+	return list, scanner.Err()
+}
+
+// Returns the public suffix of an ASCII domain name, such as "co.uk" for
+// "www.example.co.uk", using the public suffix list. If no rule in the list
+// matches the last label is returned and listed is false.
+func PublicSuffix(domain string) (suffix string, listed bool) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	labels := strings.Split(domain, ".")
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	// Check suffixes from longest to shortest so that the first match is the
+	// longest. Exceptions take priority over wildcards and remove the first
+	// label.
+	for i := range labels {
+		s := strings.Join(labels[i:], ".")
+		if _, ok := suffixes.exceptions[s]; ok {
+			return strings.Join(labels[i+1:], "."), true
+		}
+		if _, ok := suffixes.rules[s]; ok {
+			return s, true
+		}
+		if i+1 < len(labels) {
+			if _, ok := suffixes.wildcards[strings.Join(labels[i+1:], ".")]; ok {
+				return s, true
+			}
+		}
+	}
+
+	return labels[len(labels)-1], false
+}
@@ -1,7 +1,13 @@
 package email
 
 import (
-	"regexp"
+	"bufio"
+	_ "embed"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/tonyhb/govalidate/helper"
 	"github.com/tonyhb/govalidate/rules"
@@ -9,8 +15,53 @@ import (
 
 func init() {
 	rules.Add("Email", Email)
+
+	scanner := bufio.NewScanner(strings.NewReader(disposableList))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			disposable[line] = struct{}{}
+		}
+	}
+}
+
+//go:embed disposable.txt
+var disposableList string
+
+var (
+	// Domains of disposable email providers, rejected by 'Email:nodisposable'
+	disposable = map[string]struct{}{}
+	mu         sync.RWMutex
+)
+
+// Adds domains to the list of disposable email providers used by
+// 'Email:nodisposable'. Subdomains of these domains are also matched.
+func AddDisposableDomains(domains ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, d := range domains {
+		disposable[strings.ToLower(d)] = struct{}{}
+	}
 }
 
+// Validates an email address as defined by RFC 5322 and RFC 5321: a local part
+// (a dot-atom such as "first.last" or a quoted string such as "\"first last\"")
+// followed by "@" and a domain name or address literal such as "[127.0.0.1]".
+//
+// The whole value must be an address, with a local part of at most 64 octets,
+// a domain of at most 253 octets and at most 254 octets in total.
+//
+// The following arguments can be combined, eg. 'Email:utf8:noplus':
+//
+//	displayname        also accepts addresses with a display name, such as
+//	                   "\"Name\" <a@example.com>" or "Name <a@example.com>"
+//	utf8               allows Unicode in the local part (RFC 6531) and
+//	                   internationalized domain names
+//	noplus             rejects plus addressing, such as "a+tag@example.com"
+//	domains=a.com|b.c  only allows the listed domains; '*.' matches
+//	                   any subdomain
+//	notdomains=a.com   rejects the listed domains
+//	nodisposable       rejects domains of disposable email providers
 func Email(data rules.ValidationData) (err error) {
 	v, ok := helper.ToString(data.Value)
 	if ok != nil {
@@ -20,16 +71,263 @@ func Email(data rules.ValidationData) (err error) {
 		}
 	}
 
-	if IsEmail(v) {
-		return
+	opts, err := parseOptions(data.SplitArgs())
+	if err != nil {
+		return err
 	}
 
-	return rules.ErrInvalid{
-		ValidationData: data,
-		Failure:        "is not a valid email address",
+	if failure := opts.check(v); failure != "" {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        failure,
+		}
 	}
+
+	return nil
 }
 
+// Reports whether str is a valid email address, using the default options of
+// the Email rule.
 func IsEmail(str string) bool {
-	return regexp.MustCompile(`(?i)[A-Z0-9!#$%&'*+/=?^_{|}~-]+(?:\.[A-Z0-9!#$%&'*+/=?^_{|}~-]+)*@(?:[A-Z0-9](?:[A-Z0-9-]*[A-Z0-9])?\.)+[A-Z0-9](?:[A-Z0-9-]*[A-Z0-9])?`).MatchString(str)
+	_, _, ok := Parse(str, false)
+	return ok
+}
+
+// Parses an email address without a display name, returning the local part
+// and the domain. If allowUTF8 is true Unicode local parts and
+// internationalized domains are allowed.
+func Parse(str string, allowUTF8 bool) (local, domain string, ok bool) {
+	i := strings.LastIndexByte(str, '@')
+	if i < 0 {
+		return "", "", false
+	}
+	local, domain = str[:i], str[i+1:]
+
+	if len(local) > 64 || len(str) > 254 || !isLocalPart(local, allowUTF8) || !isDomain(domain, allowUTF8) {
+		return "", "", false
+	}
+	return local, domain, true
+}
+
+type options struct {
+	displayName  bool
+	utf8         bool
+	noPlus       bool
+	noDisposable bool
+	domains      []string
+	notDomains   []string
+}
+
+func parseOptions(args []string) (opts options, err error) {
+	for _, arg := range args {
+		key, value := arg, ""
+		if i := strings.Index(arg, "="); i >= 0 {
+			key, value = arg[:i], strings.ToLower(arg[i+1:])
+		}
+
+		switch key {
+		case "displayname":
+			opts.displayName = true
+		case "utf8":
+			opts.utf8 = true
+		case "noplus":
+			opts.noPlus = true
+		case "nodisposable":
+			opts.noDisposable = true
+		case "domains":
+			opts.domains = strings.Split(value, "|")
+		case "notdomains":
+			opts.notDomains = strings.Split(value, "|")
+		default:
+			return opts, fmt.Errorf("Unknown Email argument '%s' (eg 'Email:utf8:noplus')", arg)
+		}
+	}
+	return opts, nil
+}
+
+// Returns a failure message if str isn't an email address meeting the options.
+func (opts options) check(str string) string {
+	addr := str
+	if opts.displayName {
+		var ok bool
+		if addr, ok = stripDisplayName(str); !ok {
+			return "has an invalid display name"
+		}
+	}
+
+	local, domain, ok := Parse(addr, opts.utf8)
+	if !ok {
+		return "is not a valid email address"
+	}
+
+	if opts.noPlus && !strings.HasPrefix(local, "\"") && strings.IndexByte(local, '+') >= 0 {
+		return "must not use plus addressing"
+	}
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	if len(opts.domains) > 0 && !matchDomain(opts.domains, domain) {
+		return fmt.Sprintf("has a domain which isn't allowed ('%s')", domain)
+	}
+	if matchDomain(opts.notDomains, domain) {
+		return fmt.Sprintf("has a domain which isn't allowed ('%s')", domain)
+	}
+	if opts.noDisposable && isDisposable(domain) {
+		return "is a disposable email address"
+	}
+
+	return ""
+}
+
+// Returns the address from `"Display Name" <addr>` or `Name <addr>`. Strings
+// without angle brackets are returned unchanged.
+func stripDisplayName(str string) (string, bool) {
+	str = strings.TrimSpace(str)
+	if !strings.HasSuffix(str, ">") {
+		return str, true
+	}
+
+	i := strings.LastIndexByte(str, '<')
+	if i < 0 {
+		return "", false
+	}
+
+	name := strings.TrimSpace(str[:i])
+	addr := str[i+1 : len(str)-1]
+
+	if strings.HasPrefix(name, "\"") {
+		if !isQuotedString(name, true) {
+			return "", false
+		}
+		return addr, true
+	}
+
+	// phrase = 1*word, where each word is an atom. Periods are allowed for
+	// names like "John Q. Public" (obs-phrase).
+	for _, r := range name {
+		if r != ' ' && r != '.' && !isAtext(r, true) {
+			return "", false
+		}
+	}
+	return addr, true
+}
+
+// local-part = dot-atom / quoted-string
+func isLocalPart(local string, allowUTF8 bool) bool {
+	if strings.HasPrefix(local, "\"") {
+		return isQuotedString(local, allowUTF8)
+	}
+
+	if local == "" || local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return false
+	}
+	for _, r := range local {
+		if r != '.' && !isAtext(r, allowUTF8) {
+			return false
+		}
+	}
+	return true
+}
+
+// atext = ALPHA / DIGIT / "!" / "#" / "$" / "%" / "&" / "'" / "*" / "+" / "-" /
+// "/" / "=" / "?" / "^" / "_" / "`" / "{" / "|" / "}" / "~" / UTF8-non-ascii
+func isAtext(r rune, allowUTF8 bool) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case r >= utf8.RuneSelf:
+		return allowUTF8 && r != utf8.RuneError
+	}
+	return strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)
+}
+
+// quoted-string = DQUOTE *( qtext / quoted-pair / WSP ) DQUOTE
+func isQuotedString(str string, allowUTF8 bool) bool {
+	if len(str) < 2 || str[0] != '"' || str[len(str)-1] != '"' {
+		return false
+	}
+
+	escaped := false
+	for _, r := range str[1 : len(str)-1] {
+		switch {
+		case escaped:
+			// quoted-pair = "\" ( VCHAR / WSP )
+			if (r < ' ' || r > '~') && r != '\t' {
+				return false
+			}
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			return false
+		case r >= utf8.RuneSelf:
+			if !allowUTF8 || r == utf8.RuneError {
+				return false
+			}
+		case (r < ' ' || r > '~') && r != '\t':
+			return false
+		}
+	}
+	return !escaped
+}
+
+// domain = hostname with at least two labels / "[" address literal "]"
+func isDomain(domain string, allowUTF8 bool) bool {
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		literal := domain[1 : len(domain)-1]
+		if strings.HasPrefix(literal, "IPv6:") {
+			addr, err := netip.ParseAddr(literal[5:])
+			return err == nil && addr.Is6() && addr.Zone() == ""
+		}
+		addr, err := netip.ParseAddr(literal)
+		return err == nil && addr.Is4()
+	}
+
+	if !allowUTF8 && !isASCII(domain) {
+		return false
+	}
+
+	ascii, err := helper.ToASCIIHostname(domain)
+	if err != nil || !helper.IsHostname(ascii) || strings.HasSuffix(ascii, ".") {
+		return false
+	}
+
+	// Require a top level domain which isn't numeric
+	i := strings.LastIndexByte(ascii, '.')
+	return i > 0 && strings.Trim(ascii[i+1:], "0123456789") != ""
+}
+
+func isASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// Reports whether domain is in the list, where entries starting with "*."
+// match any subdomain.
+func matchDomain(list []string, domain string) bool {
+	for _, d := range list {
+		if d == domain || (strings.HasPrefix(d, "*.") && strings.HasSuffix(domain, d[1:])) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDisposable(domain string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for {
+		if _, ok := disposable[domain]; ok {
+			return true
+		}
+		i := strings.IndexByte(domain, '.')
+		if i < 0 {
+			return false
+		}
+		domain = domain[i+1:]
+	}
 }
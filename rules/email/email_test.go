@@ -0,0 +1,75 @@
+package email
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestEmail(t *testing.T) {
+	AddDisposableDomains("throwaway.test")
+
+	var tests = []struct {
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{
+			nil,
+			[]interface{}{"test@example.com", "first.last+tag@example.co.uk", "\"first last\"@example.com", "\"a\\\"b\"@example.com", "user@[192.168.0.1]", "user@[IPv6:2001:db8::1]", "o'brien@xn--bcher-kva.de", strings.Repeat("a", 64) + "@example.com"},
+			[]interface{}{"junk foo@bar.com junk", "foo@bar.com junk", "test@example", "test@example.", ".a@example.com", "a..b@example.com", "a@b@example.com", "\"unterminated@example.com", "a@-example.com", "a@example.123", "jörg@example.com", "a@bücher.de", strings.Repeat("a", 65) + "@example.com", "a@" + strings.Repeat("a.", 126) + "com", "\"Name\" <a@example.com>"},
+		},
+		{
+			[]string{"displayname"},
+			[]interface{}{"a@example.com", "\"Name\" <a@example.com>", "John Q. Public <john@example.com>", "<a@example.com>"},
+			[]interface{}{"Name <a@example>", "Na<me <a@example.com>", "\"Name <a@example.com>"},
+		},
+		{
+			[]string{"utf8"},
+			[]interface{}{"jörg@example.com", "用户@例子.广告", "a@bücher.de"},
+			[]interface{}{"a@exa☃mple.com"},
+		},
+		{
+			[]string{"noplus"},
+			[]interface{}{"a@example.com", "\"a+b\"@example.com"},
+			[]interface{}{"a+tag@example.com"},
+		},
+		{
+			[]string{"domains=example.com|*.example.org"},
+			[]interface{}{"a@example.com", "a@mail.example.org", "a@EXAMPLE.com"},
+			[]interface{}{"a@example.org", "a@sub.example.com", "a@evil.com"},
+		},
+		{
+			[]string{"notdomains=example.com"},
+			[]interface{}{"a@example.org"},
+			[]interface{}{"a@example.com"},
+		},
+		{
+			[]string{"nodisposable"},
+			[]interface{}{"a@example.com"},
+			[]interface{}{"a@mailinator.com", "a@mx.yopmail.com", "a@throwaway.test"},
+		},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := Email(object); err != nil {
+				t.Errorf("Unexpected error with valid Email%v value %v: %s", test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := Email(object); err == nil {
+				t.Errorf("Expected invalid Email%v value %v to fail validation", test.Args, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Package encoding contains rules which check that data is encoded correctly,
+// by decoding or parsing it: Base64, Base32, hex and JSON, and text which is
+// valid UTF-8, ASCII, printable or free of control characters.
+//
+// These accept strings, byte slices and json.RawMessage fields.
+package encoding
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Base64", Base64)
+	rules.Add("Base64URL", Base64URL)
+	rules.Add("Base32", Base32)
+	rules.Add("Hex", Hex)
+	rules.Add("JSON", JSON)
+	rules.Add("UTF8", UTF8)
+	rules.Add("ASCII", ASCII)
+	rules.Add("Printable", Printable)
+	rules.Add("NoControlChars", NoControlChars)
+}
+
+// Passes if the data is padded, standard Base64 (RFC 4648 section 4). Takes
+// the following arguments, which can be combined:
+//
+//	url  use the URL and filename safe alphabet, as Base64URL does
+//	raw  no padding
+func Base64(data rules.ValidationData) error {
+	return checkBase64(data, false)
+}
+
+// Passes if the data is padded Base64 using the URL and filename safe
+// alphabet (RFC 4648 section 5). 'Base64URL:raw' requires no padding.
+func Base64URL(data rules.ValidationData) error {
+	return checkBase64(data, true)
+}
+
+func checkBase64(data rules.ValidationData, url bool) error {
+	raw := false
+	for _, arg := range data.SplitArgs() {
+		switch arg {
+		case "url":
+			url = true
+		case "raw":
+			raw = true
+		default:
+			return fmt.Errorf("Unknown Base64 argument '%s': expected url or raw", arg)
+		}
+	}
+
+	enc := base64.StdEncoding
+	switch {
+	case url && raw:
+		enc = base64.RawURLEncoding
+	case url:
+		enc = base64.URLEncoding
+	case raw:
+		enc = base64.RawStdEncoding
+	}
+
+	return decode(data, "Base64", func(v string) error {
+		_, err := enc.Strict().DecodeString(v)
+		return err
+	})
+}
+
+// Passes if the data is padded Base32 (RFC 4648 section 6) whose unused
+// trailing bits are zero. Takes the following arguments, which can be
+// combined:
+//
+//	hex  use the extended hex alphabet (RFC 4648 section 7)
+//	raw  no padding
+func Base32(data rules.ValidationData) error {
+	var hex, raw bool
+	for _, arg := range data.SplitArgs() {
+		switch arg {
+		case "hex":
+			hex = true
+		case "raw":
+			raw = true
+		default:
+			return fmt.Errorf("Unknown Base32 argument '%s': expected hex or raw", arg)
+		}
+	}
+
+	enc := base32.StdEncoding
+	if hex {
+		enc = base32.HexEncoding
+	}
+	if raw {
+		enc = enc.WithPadding(base32.NoPadding)
+	}
+
+	// encoding/base32 has no strict mode, so check that the data is the
+	// canonical encoding of what it decodes to, with unused bits set to zero
+	return decode(data, "Base32", func(v string) error {
+		b, err := enc.DecodeString(v)
+		if err == nil && enc.EncodeToString(b) != v {
+			err = errors.New("non-zero trailing bits")
+		}
+		return err
+	})
+}
+
+// Runs a decoder over the data. Decoders in encoding/base64 and
+// encoding/base32 skip newlines, so these are rejected first.
+func decode(data rules.ValidationData, name string, fn func(string) error) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if strings.ContainsAny(v, "\r\n") || fn(v) != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not valid %s", name),
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a string of hexadecimal digits, in either case.
+// 'Hex:even' also requires an even number of digits, so the data decodes to
+// whole bytes.
+func Hex(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	even := false
+	if len(data.Args) > 0 {
+		if data.Args[0] != "even" {
+			return fmt.Errorf("Unknown Hex argument '%s': expected even", data.Args[0])
+		}
+		even = true
+	}
+
+	if v == "" || strings.Trim(v, "0123456789abcdefABCDEF") != "" {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not hexadecimal",
+		}
+	}
+
+	if even && len(v)%2 != 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "must have an even number of hexadecimal digits",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is well-formed JSON. 'JSON:object' and 'JSON:array'
+// require the top level value to be an object or an array.
+func JSON(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !json.Valid([]byte(v)) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not valid JSON",
+		}
+	}
+
+	if len(data.Args) == 0 {
+		return nil
+	}
+
+	var start byte
+	switch data.Args[0] {
+	case "object":
+		start = '{'
+	case "array":
+		start = '['
+	default:
+		return fmt.Errorf("Unknown JSON argument '%s': expected object or array", data.Args[0])
+	}
+
+	// As the JSON is valid its first non-whitespace character gives its type
+	if trimmed := strings.TrimLeft(v, " \t\r\n"); trimmed[0] != start {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must be a JSON %s", data.Args[0]),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package encoding
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestEncoding(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{Base64, "Base64", nil, []interface{}{"aGVsbG8=", "aGVsbG8gd29ybGQ=", "+/8=", "", []byte("aGk=")}, []interface{}{"aGVsbG8", "aGVsbG8=\n", "-_8=", "aGVsbG9=", "a GVsbG8=", 1}},
+		{Base64, "Base64", []string{"raw"}, []interface{}{"aGVsbG8", "+/8"}, []interface{}{"aGVsbG8="}},
+		{Base64, "Base64", []string{"url:raw"}, []interface{}{"-_8"}, []interface{}{"+/8", "-_8="}},
+		{Base64URL, "Base64URL", nil, []interface{}{"-_8=", "aGVsbG8="}, []interface{}{"+/8=", "-_8"}},
+		{Base64URL, "Base64URL", []string{"raw"}, []interface{}{"-_8"}, []interface{}{"-_8="}},
+		{Base32, "Base32", nil, []interface{}{"NBSWY3DP", "MFRGG===", "ME======", ""}, []interface{}{"MFRGG", "nbswy3dp", "NBSWY3D1", "NBSWY3DP\n", "MFRGH===", "MF======"}},
+		{Base32, "Base32", []string{"raw"}, []interface{}{"MFRGG", "ME"}, []interface{}{"MFRGG===", "MF"}},
+		{Base32, "Base32", []string{"hex"}, []interface{}{"D1IMOR3F"}, []interface{}{"NBSWY3DP"}},
+		{Hex, "Hex", nil, []interface{}{"deadbeef", "DEADBEEF", "abc", []byte("00ff")}, []interface{}{"", "0xdeadbeef", "xyz", "dead beef"}},
+		{Hex, "Hex", []string{"even"}, []interface{}{"deadbeef"}, []interface{}{"abc"}},
+		{JSON, "JSON", nil, []interface{}{`{"a": 1}`, `[1, 2]`, `"string"`, `null`, json.RawMessage(`{}`), []byte(`true`)}, []interface{}{`{a: 1}`, `{"a": 1`, ``, `{"a": 1} {}`, `undefined`}},
+		{JSON, "JSON", []string{"object"}, []interface{}{`{"a": 1}`, " \n{}"}, []interface{}{`[1]`, `"{}"`}},
+		{JSON, "JSON", []string{"array"}, []interface{}{`[]`, json.RawMessage(`[{"a": 1}]`)}, []interface{}{`{}`}},
+		{UTF8, "UTF8", nil, []interface{}{"café", "日本語", "", []byte("ok")}, []interface{}{"\xff", []byte{0xc3, 0x28}}},
+		{ASCII, "ASCII", nil, []interface{}{"hello, world!", "tab\there", ""}, []interface{}{"café", "\xff"}},
+		{Printable, "Printable", nil, []interface{}{"café au lait", "日本語!", ""}, []interface{}{"tab\there", "line\n", "nul\x00", "\xff", "zero\u200bwidth"}},
+		{NoControlChars, "NoControlChars", nil, []interface{}{"café", "zero\u200bwidth"}, []interface{}{"tab\there", "esc\x1b[0m", "del\x7f"}},
+		{NoControlChars, "NoControlChars", []string{"whitespace"}, []interface{}{"tab\there\r\n"}, []interface{}{"bell\a"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %q: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %q to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package encoding
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// Passes if the data is valid UTF-8. This is mostly useful for byte slices,
+// as strings read from elsewhere may hold any bytes.
+func UTF8(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !utf8.ValidString(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not valid UTF-8",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data only contains ASCII characters.
+func ASCII(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	for i := 0; i < len(v); i++ {
+		if v[i] >= utf8.RuneSelf {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "must only contain ASCII characters",
+			}
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is valid UTF-8 and only contains printable characters:
+// letters, marks, numbers, punctuation, symbols and the ASCII space. Tabs
+// and newlines aren't printable.
+func Printable(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !utf8.ValidString(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not valid UTF-8",
+		}
+	}
+
+	for _, r := range v {
+		if !unicode.IsPrint(r) {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "must only contain printable characters",
+			}
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data doesn't contain control characters, such as NUL or
+// escape. 'NoControlChars:whitespace' allows tabs, line feeds and carriage
+// returns.
+func NoControlChars(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	whitespace := false
+	if len(data.Args) > 0 {
+		if data.Args[0] != "whitespace" {
+			return fmt.Errorf("Unknown NoControlChars argument '%s': expected whitespace", data.Args[0])
+		}
+		whitespace = true
+	}
+
+	for _, r := range v {
+		if whitespace && (r == '\t' || r == '\n' || r == '\r') {
+			continue
+		}
+		if unicode.IsControl(r) {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "must not contain control characters",
+			}
+		}
+	}
+
+	return nil
+}
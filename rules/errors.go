@@ -1,6 +1,9 @@
 package rules
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type ErrInvalid struct {
 	ValidationData
@@ -11,6 +14,31 @@ func (t ErrInvalid) Error() string {
 	return fmt.Sprintf("Field '%s' %s", t.Field, t.Failure)
 }
 
+// Returned by rules which check the number of items in a collection, so that
+// the counts can be inspected without parsing the failure message.
+type ErrCount struct {
+	ErrInvalid
+
+	// The number of items in the collection
+	Count int
+
+	// The allowed number of items. Max is -1 if there is no upper limit.
+	Min, Max int
+}
+
+// Returned by rules which check several requirements at once, with an error
+// for each requirement that wasn't met. Run records each one as a separate
+// failure.
+type ErrMultiple []error
+
+func (e ErrMultiple) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
 type ErrNoValidationMethod struct {
 	Tag string
 }
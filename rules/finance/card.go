@@ -0,0 +1,118 @@
+package finance
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// A card network and the IIN ranges and lengths of its card numbers
+type network struct {
+	name    string
+	ranges  [][2]int // Inclusive prefix ranges; both bounds have the same number of digits
+	lengths []int
+}
+
+// Networks are checked in order, so more specific ranges (such as Discover's
+// 622126-622925) come before broader ones (UnionPay's 62).
+var networks = []network{
+	{"amex", [][2]int{{34, 34}, {37, 37}}, []int{15}},
+	{"visa", [][2]int{{4, 4}}, []int{13, 16, 19}},
+	{"mastercard", [][2]int{{51, 55}, {2221, 2720}}, []int{16}},
+	{"discover", [][2]int{{6011, 6011}, {644, 649}, {65, 65}, {622126, 622925}}, []int{16, 17, 18, 19}},
+	{"diners", [][2]int{{300, 305}, {36, 36}, {38, 39}}, []int{14, 15, 16, 17, 18, 19}},
+	{"jcb", [][2]int{{3528, 3589}}, []int{16, 17, 18, 19}},
+	{"unionpay", [][2]int{{62, 62}}, []int{16, 17, 18, 19}},
+	{"maestro", [][2]int{{5018, 5018}, {5020, 5020}, {5038, 5038}, {5893, 5893}, {6304, 6304}, {6759, 6759}, {6761, 6763}}, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// Passes if the data is a card number with a valid Luhn checksum. Spaces and
+// hyphens between digits are allowed, eg. "4111 1111 1111 1111".
+//
+// An optional argument restricts the card networks allowed, eg.
+// 'CreditCard:visa|mastercard'. Known networks are amex, visa, mastercard,
+// discover, diners, jcb, unionpay and maestro.
+func CreditCard(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	number := strings.NewReplacer(" ", "", "-", "").Replace(v)
+	if len(number) < 12 || len(number) > 19 || !Luhn(number) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid card number",
+		}
+	}
+
+	if len(data.Args) == 0 {
+		return nil
+	}
+
+	allowed := strings.Split(data.Args[0], "|")
+	for _, name := range allowed {
+		if !isNetwork(name) {
+			return fmt.Errorf("Unknown card network '%s' in CreditCard rule", name)
+		}
+	}
+
+	name := CardNetwork(number)
+	for _, a := range allowed {
+		if a == name {
+			return nil
+		}
+	}
+
+	return rules.ErrInvalid{
+		ValidationData: data,
+		Failure:        fmt.Sprintf("must be a %s card", strings.Join(allowed, " or ")),
+	}
+}
+
+// Returns the network of a card number, such as "visa" or "amex", or an empty
+// string if the number doesn't match a known network. This checks the prefix
+// and length only; use Luhn to check the checksum.
+func CardNetwork(number string) string {
+	for _, n := range networks {
+		if !hasLength(n, len(number)) {
+			continue
+		}
+
+		for _, r := range n.ranges {
+			digits := len(strconv.Itoa(r[0]))
+			if len(number) < digits {
+				continue
+			}
+			prefix, err := strconv.Atoi(number[:digits])
+			if err == nil && prefix >= r[0] && prefix <= r[1] {
+				return n.name
+			}
+		}
+	}
+	return ""
+}
+
+func hasLength(n network, length int) bool {
+	for _, l := range n.lengths {
+		if l == length {
+			return true
+		}
+	}
+	return false
+}
+
+func isNetwork(name string) bool {
+	for _, n := range networks {
+		if n.name == name {
+			return true
+		}
+	}
+	return false
+}
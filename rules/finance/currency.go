@@ -0,0 +1,182 @@
+package finance
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// ISO 4217 currency codes and the number of decimal places (minor units) each
+// uses. Codes without minor units, such as the precious metals, are -1.
+var currencies = map[string]int{
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2,
+	"AUD": 2, "AWG": 2, "AZN": 2, "BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2,
+	"BHD": 3, "BIF": 0, "BMD": 2, "BND": 2, "BOB": 2, "BOV": 2, "BRL": 2,
+	"BSD": 2, "BTN": 2, "BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2,
+	"CHE": 2, "CHF": 2, "CHW": 2, "CLF": 4, "CLP": 0, "CNY": 2, "COP": 2,
+	"COU": 2, "CRC": 2, "CUP": 2, "CVE": 2, "CZK": 2, "DJF": 0, "DKK": 2,
+	"DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2, "EUR": 2, "FJD": 2,
+	"FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2, "GMD": 2, "GNF": 0,
+	"GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2, "HTG": 2, "HUF": 2, "IDR": 2,
+	"ILS": 2, "INR": 2, "IQD": 3, "IRR": 2, "ISK": 0, "JMD": 2, "JOD": 3,
+	"JPY": 0, "KES": 2, "KGS": 2, "KHR": 2, "KMF": 0, "KPW": 2, "KRW": 0,
+	"KWD": 3, "KYD": 2, "KZT": 2, "LAK": 2, "LBP": 2, "LKR": 2, "LRD": 2,
+	"LSL": 2, "LYD": 3, "MAD": 2, "MDL": 2, "MGA": 2, "MKD": 2, "MMK": 2,
+	"MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2, "MWK": 2, "MXN": 2,
+	"MXV": 2, "MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2, "NOK": 2,
+	"NPR": 2, "NZD": 2, "OMR": 3, "PAB": 2, "PEN": 2, "PGK": 2, "PHP": 2,
+	"PKR": 2, "PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2,
+	"RWF": 0, "SAR": 2, "SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2,
+	"SHP": 2, "SLE": 2, "SOS": 2, "SRD": 2, "SSP": 2, "STN": 2, "SVC": 2,
+	"SYP": 2, "SZL": 2, "THB": 2, "TJS": 2, "TMT": 2, "TND": 3, "TOP": 2,
+	"TRY": 2, "TTD": 2, "TWD": 2, "TZS": 2, "UAH": 2, "UGX": 0, "USD": 2,
+	"USN": 2, "UYI": 0, "UYU": 2, "UYW": 4, "UZS": 2, "VED": 2, "VES": 2,
+	"VND": 0, "VUV": 0, "WST": 2, "XAF": 0, "XCD": 2, "XCG": 2, "XOF": 0,
+	"XPF": 0, "YER": 2, "ZAR": 2, "ZMW": 2, "ZWG": 2,
+	"XAG": -1, "XAU": -1, "XBA": -1, "XBB": -1, "XBC": -1, "XBD": -1,
+	"XDR": -1, "XPD": -1, "XPT": -1, "XSU": -1, "XTS": -1, "XUA": -1,
+	"XXX": -1,
+}
+
+var rxAmount = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// Returns the number of decimal places used by an ISO 4217 currency, such as
+// 2 for "USD" and 0 for "JPY". ok is false if the code isn't known. Currencies
+// without minor units, such as "XAU", return -1.
+func CurrencyMinorUnits(code string) (digits int, ok bool) {
+	digits, ok = currencies[code]
+	return digits, ok
+}
+
+// Passes if the data is an upper case ISO 4217 currency code, such as "USD".
+func Currency(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if _, ok := currencies[v]; !ok {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ISO 4217 currency code",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is an amount with no more decimal places than a currency
+// allows. The currency can be given directly ('Amount:USD'), taken from
+// another field ('Amount:field=Currency') or replaced with a number of decimal
+// places ('Amount:2').
+//
+// The data can be a decimal string such as "10.50", a json.Number or any
+// numeric type. Floats are checked using their shortest decimal
+// representation, so prefer strings or integer minor units for money.
+func Amount(data rules.ValidationData) error {
+	// We should always be provided with a currency to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'Amount:USD')")
+	}
+
+	places, currency, err := amountPlaces(data)
+	if err != nil {
+		return err
+	}
+	if currency == "" && places < 0 {
+		// The currency field is empty so there's nothing to check against
+		return nil
+	}
+
+	decimals, ok := amountDecimals(data.Value)
+	if !ok {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid amount",
+		}
+	}
+
+	if places >= 0 && decimals > places {
+		failure := fmt.Sprintf("must have at most %d decimal places", places)
+		if currency != "" {
+			failure = fmt.Sprintf("must have at most %d decimal places for %s", places, currency)
+		}
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        failure,
+		}
+	}
+
+	return nil
+}
+
+// Resolves the Amount argument into a number of decimal places and the
+// currency it came from, if any
+func amountPlaces(data rules.ValidationData) (places int, currency string, err error) {
+	arg := data.Args[0]
+
+	if name, ok := rules.FieldArg(arg); ok {
+		sibling, err := data.Sibling(name)
+		if err != nil {
+			return 0, "", err
+		}
+		if currency, err = helper.ToString(sibling); err != nil {
+			return 0, "", fmt.Errorf("Field '%s' can't take its currency from '%s': it is not a string", data.Field, name)
+		}
+		if currency == "" {
+			return -1, "", nil
+		}
+		if places, ok = currencies[currency]; !ok {
+			return 0, "", rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        fmt.Sprintf("has an unknown currency '%s' in %s", currency, name),
+			}
+		}
+		return places, currency, nil
+	}
+
+	if places, ok := currencies[arg]; ok {
+		return places, arg, nil
+	}
+
+	if places, err = strconv.Atoi(arg); err != nil || places < 0 {
+		return 0, "", fmt.Errorf("Invalid Amount argument '%s': expected a currency code, 'field=Name' or a number of decimal places", arg)
+	}
+	return places, "", nil
+}
+
+// Returns the number of decimal places in an amount
+func amountDecimals(value interface{}) (int, bool) {
+	if helper.IsInt(value) || helper.IsUint(value) {
+		return 0, true
+	}
+
+	var str string
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Float32:
+		str = strconv.FormatFloat(reflect.ValueOf(value).Float(), 'f', -1, 32)
+	case reflect.Float64:
+		str = strconv.FormatFloat(reflect.ValueOf(value).Float(), 'f', -1, 64)
+	default:
+		var err error
+		if str, err = helper.ToString(value); err != nil {
+			return 0, false
+		}
+	}
+
+	if !rxAmount.MatchString(str) {
+		return 0, false
+	}
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		return len(str) - i - 1, true
+	}
+	return 0, true
+}
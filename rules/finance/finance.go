@@ -0,0 +1,44 @@
+// Package finance contains rules for financial identifiers and amounts: card
+// numbers, IBANs, BIC codes, ISINs, ISO 4217 currency codes and currency
+// amounts.
+package finance
+
+import (
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("CreditCard", CreditCard)
+	rules.Add("IBAN", IBAN)
+	rules.Add("BIC", BIC)
+	rules.Add("ISIN", ISIN)
+	rules.Add("Currency", Currency)
+	rules.Add("Amount", Amount)
+}
+
+// Reports whether a string of digits passes the Luhn (mod 10) checksum used
+// by card numbers and ISINs.
+func Luhn(digits string) bool {
+	if digits == "" {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+
+		n := int(c - '0')
+		if double {
+			if n *= 2; n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+		double = !double
+	}
+	return sum%10 == 0
+}
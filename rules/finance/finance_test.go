@@ -0,0 +1,104 @@
+package finance
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestFinance(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{CreditCard, "CreditCard", nil, []interface{}{"4111111111111111", "4111 1111 1111 1111", "4111-1111-1111-1111", "378282246310005", "5555555555554444"}, []interface{}{"4111111111111112", "411111111111", "41111111111111111111", "4111a11111111111", "", 4111111111111111}},
+		{CreditCard, "CreditCard", []string{"visa|mastercard"}, []interface{}{"4012888888881881", "5555555555554444", "2223003122003222"}, []interface{}{"378282246310005", "6011111111111117"}},
+		{CreditCard, "CreditCard", []string{"amex"}, []interface{}{"371449635398431"}, []interface{}{"4111111111111111"}},
+		{IBAN, "IBAN", nil, []interface{}{"GB82WEST12345698765432", "GB82 WEST 1234 5698 7654 32", "DE89370400440532013000", "FR1420041010050500013M02606", "gb82west12345698765432"}, []interface{}{"GB82WEST12345698765431", "GB82WEST1234569876543", "ZZ82WEST12345698765432", "GB00WEST12345698765432", "GB82-WEST-1234-5698-7654-32", ""}},
+		{BIC, "BIC", nil, []interface{}{"DEUTDEFF", "DEUTDEFF500", "NEDSZAJJXXX"}, []interface{}{"DEUTDEF", "DEUTDEFF50", "deutdeff", "DEU1DEFF", "DEUTD1FF"}},
+		{ISIN, "ISIN", nil, []interface{}{"US0378331005", "AU0000XVGZA3", "GB0002634946"}, []interface{}{"US0378331006", "US037833100", "us0378331005", "1S0378331005"}},
+		{Currency, "Currency", nil, []interface{}{"USD", "EUR", "JPY", "XAU"}, []interface{}{"usd", "ZZZ", "US", ""}},
+		{Amount, "Amount", []string{"USD"}, []interface{}{"10", "10.5", "10.50", "-0.01", 10, uint8(3), 10.25, json.Number("99.99")}, []interface{}{"10.505", "1e3", "10.", ".5", "", 10.125, json.Number("1.001")}},
+		{Amount, "Amount", []string{"JPY"}, []interface{}{"1000", 1000}, []interface{}{"1000.5", 0.5}},
+		{Amount, "Amount", []string{"KWD"}, []interface{}{"1.125"}, []interface{}{"1.1255"}},
+		{Amount, "Amount", []string{"0"}, []interface{}{"12"}, []interface{}{"12.0"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %v: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %v to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
+
+func TestCardNetwork(t *testing.T) {
+	var tests = map[string]string{
+		"4111111111111111": "visa",
+		"5555555555554444": "mastercard",
+		"2223003122003222": "mastercard",
+		"378282246310005":  "amex",
+		"6011111111111117": "discover",
+		"6221260000000000": "discover",
+		"6200000000000005": "unionpay",
+		"30569309025904":   "diners",
+		"3530111333300000": "jcb",
+		"1234567890123":    "",
+	}
+
+	for number, expected := range tests {
+		if network := CardNetwork(number); network != expected {
+			t.Errorf("Expected %s to be a %q card, got %q", number, expected, network)
+		}
+	}
+}
+
+func TestAmountCurrencyField(t *testing.T) {
+	type Payment struct {
+		Amount   string
+		Currency string
+	}
+
+	var tests = []struct {
+		Payment Payment
+		Valid   bool
+	}{
+		{Payment{"10.50", "USD"}, true},
+		{Payment{"10.50", "JPY"}, false},
+		{Payment{"10.500", "BHD"}, true},
+		{Payment{"10.50", "ZZZ"}, false},
+		{Payment{"10.50", ""}, true},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field:  "Amount",
+			Value:  test.Payment.Amount,
+			Args:   []string{"field=Currency"},
+			Struct: reflect.ValueOf(test.Payment),
+		}
+
+		if err := Amount(object); (err == nil) != test.Valid {
+			t.Errorf("Unexpected result validating %v: %v", test.Payment, err)
+		}
+	}
+}
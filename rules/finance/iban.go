@@ -0,0 +1,144 @@
+package finance
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// The length of IBANs in each country using them, from the SWIFT IBAN registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16,
+	"BG": 22, "BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22,
+	"CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20,
+	"EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22,
+	"GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28,
+	"IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30,
+	"KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+	"LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+	"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24,
+	"PL": 28, "PS": 29, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24,
+	"SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23,
+	"ST": 25, "SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22,
+	"VG": 24, "XK": 20, "YE": 30,
+}
+
+var (
+	rxIBAN = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+	rxBIC  = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	rxISIN = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{9}[0-9]$`)
+)
+
+// Passes if the data is an IBAN with the correct length for its country and
+// a valid mod-97 checksum. The electronic form ("GB82WEST12345698765432") and
+// the print form with spaces between groups of four are both accepted.
+func IBAN(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !IsIBAN(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid IBAN",
+		}
+	}
+
+	return nil
+}
+
+// Reports whether str is a valid IBAN, ignoring spaces and case.
+func IsIBAN(str string) bool {
+	iban := strings.ToUpper(strings.Replace(str, " ", "", -1))
+	if !rxIBAN.MatchString(iban) {
+		return false
+	}
+
+	if length, ok := ibanLengths[iban[:2]]; !ok || len(iban) != length {
+		return false
+	}
+
+	if check := iban[2:4]; check == "00" || check == "01" || check == "99" {
+		return false
+	}
+
+	// Move the country code and check digits to the end, replace letters
+	// with numbers (A = 10 ... Z = 35) and the result mod 97 must be 1.
+	// The remainder is computed piecewise so it never overflows.
+	rem := 0
+	for _, c := range iban[4:] + iban[:4] {
+		if c >= 'A' {
+			rem = (rem*100 + int(c-'A') + 10) % 97
+		} else {
+			rem = (rem*10 + int(c-'0')) % 97
+		}
+	}
+	return rem == 1
+}
+
+// Passes if the data is an 8 or 11 character BIC (SWIFT) code, such as
+// "DEUTDEFF" or "DEUTDEFF500".
+func BIC(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !rxBIC.MatchString(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid BIC",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a 12 character ISIN, such as "US0378331005", with a
+// valid check digit.
+func ISIN(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !IsISIN(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ISIN",
+		}
+	}
+
+	return nil
+}
+
+// Reports whether str is a valid ISIN. Letters are expanded to two digits
+// (A = 10 ... Z = 35) and the result must pass the Luhn check.
+func IsISIN(str string) bool {
+	if !rxISIN.MatchString(str) {
+		return false
+	}
+
+	var digits strings.Builder
+	for _, c := range str {
+		if c >= 'A' {
+			digits.WriteString(strconv.Itoa(int(c-'A') + 10))
+		} else {
+			digits.WriteRune(c)
+		}
+	}
+	return Luhn(digits.String())
+}
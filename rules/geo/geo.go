@@ -0,0 +1,114 @@
+// Package geo contains rules for geographic coordinates and GeoJSON
+// geometries.
+package geo
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Latitude", Latitude)
+	rules.Add("Longitude", Longitude)
+	rules.Add("LatLng", LatLng)
+	rules.Add("GeoJSON", GeoJSON)
+}
+
+var rxDecimal = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// Passes if the data is a latitude between -90 and 90 inclusive. The data
+// can be any numeric type or a decimal string such as "51.5074".
+func Latitude(data rules.ValidationData) error {
+	return checkCoordinate(data, "latitude", 90)
+}
+
+// Passes if the data is a longitude between -180 and 180 inclusive. The data
+// can be any numeric type or a decimal string such as "-0.1278".
+func Longitude(data rules.ValidationData) error {
+	return checkCoordinate(data, "longitude", 180)
+}
+
+func checkCoordinate(data rules.ValidationData, name string, limit float64) error {
+	f, ok := toCoordinate(data.Value)
+	if !ok {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a valid %s", name),
+		}
+	}
+
+	if f < -limit || f > limit {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must be a %s between -%g and %g", name, limit, limit),
+		}
+	}
+
+	return nil
+}
+
+// Converts numeric data or a decimal string into a float
+func toCoordinate(value interface{}) (float64, bool) {
+	if helper.IsNumeric(value) {
+		f, err := helper.ToFloat64(value)
+		return f, err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+	}
+
+	str, err := helper.ToString(value)
+	if err != nil {
+		return 0, false
+	}
+	return parseDecimal(str)
+}
+
+func parseDecimal(str string) (float64, bool) {
+	if !rxDecimal.MatchString(str) {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	return f, err == nil
+}
+
+// Passes if the data is a "lat,lng" string such as "51.5074,-0.1278", with an
+// optional space after the comma.
+func LatLng(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	parts := strings.Split(v, ",")
+	if len(parts) != 2 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a 'lat,lng' coordinate pair",
+		}
+	}
+
+	lat, latOK := parseDecimal(parts[0])
+	lng, lngOK := parseDecimal(strings.TrimPrefix(parts[1], " "))
+	if !latOK || !lngOK {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a 'lat,lng' coordinate pair",
+		}
+	}
+
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "must have a latitude between -90 and 90 and a longitude between -180 and 180",
+		}
+	}
+
+	return nil
+}
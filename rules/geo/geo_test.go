@@ -0,0 +1,85 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestGeo(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{Latitude, "Latitude", nil, []interface{}{51.5074, -90, 90, float32(45.5), "51.5074", "-33.8688", json.Number("10")}, []interface{}{90.0001, -91, "91", "NaN", "1e1", "51.5074N", "", true}},
+		{Longitude, "Longitude", nil, []interface{}{-0.1278, 180, -180, "151.2093", uint8(100)}, []interface{}{180.5, -181, "-180.1", "Inf"}},
+		{LatLng, "LatLng", nil, []interface{}{"51.5074,-0.1278", "51.5074, -0.1278", "-90,180", "0,0"}, []interface{}{"51.5074", "-0.1278,51.5074,1", "91,0", "0,181", "51.5074 ,-0.1278", "a,b", ""}},
+		{GeoJSON, "GeoJSON", nil, []interface{}{
+			json.RawMessage(`{"type": "Point", "coordinates": [-0.1278, 51.5074]}`),
+			[]byte(`{"type": "Point", "coordinates": [-0.1278, 51.5074, 11.5]}`),
+			`{"type": "LineString", "coordinates": [[0, 0], [1, 1]]}`,
+			`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}`,
+			`{"type": "Polygon", "coordinates": [[[0, 0], [10, 0], [10, 10], [0, 0]], [[1, 1], [2, 1], [2, 2], [1, 1]]]}`,
+			`{"type": "MultiPoint", "coordinates": [[0, 0], [1, 1]]}`,
+			`{"type": "MultiLineString", "coordinates": [[[0, 0], [1, 1]]]}`,
+			`{"type": "MultiPolygon", "coordinates": [[[[0, 0], [1, 0], [1, 1], [0, 0]]]]}`,
+			`{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": [0, 0]}]}`,
+		}, []interface{}{
+			`{"type": "Point", "coordinates": [51.5074, -0.1278, 1, 2]}`,
+			`{"type": "Point", "coordinates": [-0.1278, 91]}`,
+			`{"type": "Point", "coordinates": [181, 0]}`,
+			`{"type": "Point", "coordinates": ["0", "0"]}`,
+			`{"type": "Point"}`,
+			`{"type": "Circle", "coordinates": [0, 0]}`,
+			`{"coordinates": [0, 0]}`,
+			`{"type": "LineString", "coordinates": [[0, 0]]}`,
+			`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1]]]}`,
+			`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [0, 0]]]}`,
+			`{"type": "Polygon", "coordinates": []}`,
+			`{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": [0, 91]}]}`,
+			`{"type": "Point", "coordinates": null}`,
+			`{"type": "Point", "coordinates": []}`,
+			`{"type": "MultiPoint", "coordinates": null}`,
+			`{"type": "MultiPoint", "coordinates": [ ]}`,
+			`{"type": "MultiPoint", "coordinates": [null]}`,
+			`{"type": "LineString", "coordinates": null}`,
+			`{"type": "MultiLineString", "coordinates": null}`,
+			`{"type": "MultiLineString", "coordinates": []}`,
+			`{"type": "MultiPolygon", "coordinates": null}`,
+			`{"type": "MultiPolygon", "coordinates": []}`,
+			`{"type": "MultiPolygon", "coordinates": [null]}`,
+			`{"type": "GeometryCollection", "geometries": null}`,
+			`{"type": "GeometryCollection", "geometries": [null]}`,
+			`{"type": "GeometryCollection"}`,
+			`[0, 0]`,
+			`not json`,
+			1,
+		}},
+		{GeoJSON, "GeoJSON", []string{"Polygon|MultiPolygon"}, []interface{}{`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}`}, []interface{}{`{"type": "Point", "coordinates": [0, 0]}`}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %v: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %v to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// A GeoJSON geometry object, as defined by RFC 7946 section 3.1
+type geometry struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates"`
+	Geometries  []json.RawMessage `json:"geometries"`
+}
+
+// Passes if the data is a GeoJSON geometry (RFC 7946) held in a
+// json.RawMessage, []byte or string. This checks the geometry's structure,
+// that positions are within the bounds of longitude and latitude, that line
+// strings have at least two positions and that polygon rings are closed with
+// at least four positions.
+//
+// Point, MultiPoint, LineString, MultiLineString, Polygon, MultiPolygon and
+// GeometryCollection geometries are accepted. An optional argument restricts
+// the types allowed, eg. 'GeoJSON:Polygon|MultiPolygon'.
+func GeoJSON(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string or byte slice",
+		}
+	}
+
+	g, err := checkGeometry(json.RawMessage(v))
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a valid GeoJSON geometry: %s", err),
+		}
+	}
+
+	if len(data.Args) == 0 {
+		return nil
+	}
+
+	allowed := strings.Split(data.Args[0], "|")
+	for _, t := range allowed {
+		if t == g.Type {
+			return nil
+		}
+	}
+
+	return rules.ErrInvalid{
+		ValidationData: data,
+		Failure:        fmt.Sprintf("must be a GeoJSON %s", strings.Join(allowed, " or ")),
+	}
+}
+
+// Parses and checks a geometry, returning an error describing the first
+// problem found
+func checkGeometry(raw json.RawMessage) (g geometry, err error) {
+	if err = json.Unmarshal(raw, &g); err != nil {
+		return g, errors.New("it is not a JSON object")
+	}
+
+	if g.Type == "GeometryCollection" {
+		if g.Geometries == nil {
+			return g, errors.New("a GeometryCollection must have geometries")
+		}
+		for _, member := range g.Geometries {
+			if _, err := checkGeometry(member); err != nil {
+				return g, err
+			}
+		}
+		return g, nil
+	}
+
+	if g.Type == "" {
+		return g, errors.New("it has no type")
+	}
+
+	// Coordinates which are missing, null or an empty array are rejected for
+	// every type, as they'd otherwise decode to an empty slice
+	var items []json.RawMessage
+	if g.Coordinates == nil || json.Unmarshal(g.Coordinates, &items) == nil && len(items) == 0 {
+		return g, fmt.Errorf("a %s must have coordinates", g.Type)
+	}
+
+	switch g.Type {
+	case "Point":
+		var p []float64
+		if err = unmarshalCoordinates(g, &p); err == nil {
+			err = checkPosition(p)
+		}
+	case "MultiPoint":
+		var ps [][]float64
+		if err = unmarshalCoordinates(g, &ps); err == nil {
+			err = checkPositions(ps)
+		}
+	case "LineString":
+		var line [][]float64
+		if err = unmarshalCoordinates(g, &line); err == nil {
+			err = checkLineString(line)
+		}
+	case "MultiLineString":
+		var lines [][][]float64
+		if err = unmarshalCoordinates(g, &lines); err == nil {
+			for _, line := range lines {
+				if err = checkLineString(line); err != nil {
+					break
+				}
+			}
+		}
+	case "Polygon":
+		var polygon [][][]float64
+		if err = unmarshalCoordinates(g, &polygon); err == nil {
+			err = checkPolygon(polygon)
+		}
+	case "MultiPolygon":
+		var polygons [][][][]float64
+		if err = unmarshalCoordinates(g, &polygons); err == nil {
+			for _, polygon := range polygons {
+				if err = checkPolygon(polygon); err != nil {
+					break
+				}
+			}
+		}
+	default:
+		err = fmt.Errorf("'%s' is not a geometry type", g.Type)
+	}
+
+	return g, err
+}
+
+func unmarshalCoordinates(g geometry, v interface{}) error {
+	if err := json.Unmarshal(g.Coordinates, v); err != nil {
+		return fmt.Errorf("a %s has invalid coordinates", g.Type)
+	}
+	return nil
+}
+
+// A position is a longitude, latitude and optional altitude
+func checkPosition(p []float64) error {
+	if len(p) < 2 || len(p) > 3 {
+		return errors.New("positions must have 2 or 3 numbers")
+	}
+	if p[0] < -180 || p[0] > 180 {
+		return fmt.Errorf("longitude %g is out of range", p[0])
+	}
+	if p[1] < -90 || p[1] > 90 {
+		return fmt.Errorf("latitude %g is out of range", p[1])
+	}
+	return nil
+}
+
+func checkPositions(ps [][]float64) error {
+	for _, p := range ps {
+		if err := checkPosition(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkLineString(line [][]float64) error {
+	if len(line) < 2 {
+		return errors.New("a LineString must have at least 2 positions")
+	}
+	return checkPositions(line)
+}
+
+func checkPolygon(rings [][][]float64) error {
+	if len(rings) == 0 {
+		return errors.New("a Polygon must have at least one ring")
+	}
+
+	for _, ring := range rings {
+		if len(ring) < 4 {
+			return errors.New("polygon rings must have at least 4 positions")
+		}
+		if err := checkPositions(ring); err != nil {
+			return err
+		}
+		if !samePosition(ring[0], ring[len(ring)-1]) {
+			return errors.New("polygon rings must be closed")
+		}
+	}
+	return nil
+}
+
+func samePosition(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package greaterthanorequal
+
+import (
+	"fmt"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("GreaterThanOrEqual", GreaterThanOrEqual)
+}
+
+// Passes if the data is numeric and is greater than or equal to the number
+// specified in your tag.
+// Fails if the data is not numeric or the data is less than the comparator
+func GreaterThanOrEqual(data rules.ValidationData) error {
+	v, err := helper.ToBigFloat(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not numeric",
+		}
+	}
+
+	// We should always be provided with a number to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'GreaterThanOrEqual:5')")
+	}
+
+	// Typecast our argument and test
+	min, err := helper.ParseNumber(data.Args[0])
+	if err != nil {
+		return err
+	}
+
+	if v.Cmp(min) < 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must be greater than or equal to %s", data.Args[0]),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Package ids contains rules for sortable and random identifier formats other
+// than UUIDs: ULID, KSUID, XID, Snowflake and NanoID.
+//
+// Rules for formats with an embedded timestamp check that the timestamp isn't
+// further in the future than an allowed clock skew, which defaults to one
+// minute and can be changed with a 'skew' argument, eg. 'ULID:skew=5s'. The
+// current time comes from the validator's Now option.
+package ids
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("ULID", ULID)
+	rules.Add("KSUID", KSUID)
+	rules.Add("XID", XID)
+	rules.Add("Snowflake", Snowflake)
+	rules.Add("NanoID", NanoID)
+}
+
+// The default allowed difference between an ID's timestamp and now
+const DefaultSkew = time.Minute
+
+// Parses "key=value" arguments, checking that each key is allowed.
+func parseArgs(rule string, args []string, allowed ...string) (map[string]string, error) {
+	parsed := map[string]string{}
+
+	for _, arg := range args {
+		i := strings.Index(arg, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("Unknown %s argument '%s'", rule, arg)
+		}
+
+		key, value := arg[:i], arg[i+1:]
+		found := false
+		for _, a := range allowed {
+			found = found || a == key
+		}
+		if !found {
+			return nil, fmt.Errorf("Unknown %s argument '%s'", rule, arg)
+		}
+		parsed[key] = value
+	}
+
+	return parsed, nil
+}
+
+// Returns the skew argument, or DefaultSkew if it isn't set.
+func skew(args map[string]string) (time.Duration, error) {
+	if s, ok := args["skew"]; ok {
+		return time.ParseDuration(s)
+	}
+	return DefaultSkew, nil
+}
+
+// Returns an ErrInvalid if t is further in the future than the allowed skew.
+func checkTime(data rules.ValidationData, args map[string]string, t time.Time) error {
+	d, err := skew(args)
+	if err != nil {
+		return err
+	}
+
+	if now := data.Options.Time(); t.After(now.Add(d)) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("has a timestamp in the future (%s)", t.UTC().Format(time.RFC3339)),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package ids
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestIDs(t *testing.T) {
+	// 2020-01-02 00:00:00 UTC
+	now := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{ULID, "ULID", nil, []interface{}{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01arz3ndektsv4rrffq69g5fav"}, []interface{}{"01ARZ3NDEKTSV4RRFFQ69G5FA", "01ARZ3NDEKTSV4RRFFQ69G5FAU", "81ARZ3NDEKTSV4RRFFQ69G5FAV", "03QCPC7P000000000000000000", 1}},
+		{ULID, "ULID", []string{"skew=876000h"}, []interface{}{"03QCPC7P000000000000000000"}, nil},
+		{KSUID, "KSUID", nil, []interface{}{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", "000000000000000000000000000"}, []interface{}{"0ujtsYcgvSTl8PAuAdqWYSMnLO", "0ujtsYcgvSTl8PAuAdqWYSMnLO!", "aWgEPTl1tmebfsQzFP4bxwgy80W", "aWgEPTl1tmebfsQzFP4bxwgy80V"}},
+		{XID, "XID", nil, []interface{}{"9m4e2mr0ui3e8a215n4g"}, []interface{}{"9m4e2mr0ui3e8a215n4", "9m4e2mr0ui3e8a215n4h", "9M4E2MR0UI3E8A215N4G", "9m4e2mr0ui3e8a215n4w", "vvvvvvvvvvvvvvvvvvvg"}},
+		{Snowflake, "Snowflake", nil, []interface{}{"1212161838843592705", int64(1212161838843592704)}, []interface{}{"0", -1, "12a", "9223372036854775807", 1.5}},
+		{Snowflake, "Snowflake", []string{"epoch=discord"}, []interface{}{"175928847299117063", uint64(175928847299117063)}, []interface{}{"1212161838843592705"}},
+		{NanoID, "NanoID", nil, []interface{}{"V1StGXR8_Z5jdHi6B-myT"}, []interface{}{"V1StGXR8_Z5jdHi6B-my", "V1StGXR8_Z5jdHi6B+myT"}},
+		{NanoID, "NanoID", []string{"size=8:alphabet=0123456789abcdef"}, []interface{}{"deadbeef"}, []interface{}{"DEADBEEF", "deadbee"}},
+		{NanoID, "NanoID", []string{"size=4:'alphabet=ab:'"}, []interface{}{"a:b:", "bbbb"}, []interface{}{"a-b-", "a'b:"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+			Options: rules.Options{
+				Now: func() time.Time { return now },
+			},
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %v: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %v to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package ids
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+const (
+	base62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	// KSUID timestamps are seconds since this Unix time
+	ksuidEpoch = 1400000000
+)
+
+// The largest KSUID: 20 bytes of 0xff
+var maxKSUID = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+
+// Passes if the data is a KSUID (https://github.com/segmentio/ksuid): 27
+// characters of base62 encoding 20 bytes, whose first 4 bytes hold a timestamp
+// which isn't in the future.
+func KSUID(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	args, err := parseArgs("KSUID", data.SplitArgs(), "skew")
+	if err != nil {
+		return err
+	}
+
+	if len(v) != 27 || strings.Trim(v, base62) != "" {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid KSUID",
+		}
+	}
+
+	n := new(big.Int)
+	for i := 0; i < len(v); i++ {
+		n.Mul(n, big.NewInt(62))
+		n.Add(n, big.NewInt(int64(strings.IndexByte(base62, v[i]))))
+	}
+
+	if n.Cmp(maxKSUID) > 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid KSUID; it is too large",
+		}
+	}
+
+	// The timestamp is the top 32 of the 160 bits
+	seconds := new(big.Int).Rsh(n, 128).Int64()
+	return checkTime(data, args, time.Unix(seconds+ksuidEpoch, 0))
+}
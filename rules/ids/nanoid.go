@@ -0,0 +1,62 @@
+package ids
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// NanoID's default URL-safe alphabet
+const nanoidAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+// Passes if the data is a NanoID (https://github.com/ai/nanoid): by default 21
+// characters of the URL-safe alphabet A-Z, a-z, 0-9, "_" and "-".
+//
+// Use 'NanoID:size=10' for a different length and
+// 'NanoID:alphabet=0123456789abcdef' for a custom alphabet. Quote an alphabet
+// containing a colon, eg. "NanoID:'alphabet=ab:'".
+func NanoID(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	args, err := parseArgs("NanoID", data.SplitArgs(), "size", "alphabet")
+	if err != nil {
+		return err
+	}
+
+	size := 21
+	if s, ok := args["size"]; ok {
+		if size, err = strconv.Atoi(s); err != nil {
+			return err
+		}
+	}
+
+	alphabet := nanoidAlphabet
+	if a, ok := args["alphabet"]; ok {
+		alphabet = a
+	}
+
+	if len(v) != size {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a valid NanoID; it must be %d characters long", size),
+		}
+	}
+
+	if strings.Trim(v, alphabet) != "" {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid NanoID; it contains characters outside of its alphabet",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ids
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// Epochs, in Unix milliseconds, of well known Snowflake implementations
+var snowflakeEpochs = map[string]int64{
+	"twitter":   1288834974657,
+	"discord":   1420070400000,
+	"instagram": 1314220021721,
+	"unix":      0,
+}
+
+// Passes if the data is a Snowflake ID: a positive 63 bit integer, given as a
+// number or a decimal string, whose top 41 bits hold a millisecond timestamp
+// which isn't in the future.
+//
+// Timestamps are relative to Twitter's epoch by default. Use
+// 'Snowflake:epoch=discord' (or 'twitter', 'instagram' or 'unix') or
+// 'Snowflake:epoch=1420070400000' with an epoch in Unix milliseconds for other
+// implementations.
+func Snowflake(data rules.ValidationData) error {
+	args, err := parseArgs("Snowflake", data.SplitArgs(), "skew", "epoch")
+	if err != nil {
+		return err
+	}
+
+	epoch := snowflakeEpochs["twitter"]
+	if e, ok := args["epoch"]; ok {
+		if epoch, ok = snowflakeEpochs[e]; !ok {
+			if epoch, err = strconv.ParseInt(e, 10, 64); err != nil {
+				return fmt.Errorf("Invalid Snowflake epoch '%s'", e)
+			}
+		}
+	}
+
+	var str string
+	if n, err := helper.ToBigFloat(data.Value); err == nil && n.IsInt() {
+		str = n.Text('f', 0)
+	} else if str, err = helper.ToString(data.Value); err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a number or string",
+		}
+	}
+
+	id, err := strconv.ParseInt(str, 10, 64)
+	if err != nil || id <= 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid Snowflake ID",
+		}
+	}
+
+	return checkTime(data, args, time.UnixMilli((id>>22)+epoch))
+}
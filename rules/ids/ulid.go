@@ -0,0 +1,53 @@
+package ids
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// Crockford's base32 alphabet, used by ULIDs
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Passes if the data is a ULID (https://github.com/ulid/spec): 26 characters
+// of Crockford's base32 in either case, whose first 10 characters hold a
+// millisecond timestamp which isn't in the future.
+func ULID(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	args, err := parseArgs("ULID", data.SplitArgs(), "skew")
+	if err != nil {
+		return err
+	}
+
+	v = strings.ToUpper(v)
+	if len(v) != 26 || strings.Trim(v, crockford) != "" {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ULID",
+		}
+	}
+
+	// 26 characters hold 130 bits, so the first character can't be over 7
+	if v[0] > '7' {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ULID; it is too large",
+		}
+	}
+
+	var ms int64
+	for i := 0; i < 10; i++ {
+		ms = ms<<5 | int64(strings.IndexByte(crockford, v[i]))
+	}
+
+	return checkTime(data, args, time.UnixMilli(ms))
+}
@@ -0,0 +1,51 @@
+package ids
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// XIDs use lower case base32hex without padding
+var xidEncoding = base32.NewEncoding("0123456789abcdefghijklmnopqrstuv").WithPadding(base32.NoPadding)
+
+// Passes if the data is an XID (https://github.com/rs/xid): 20 characters of
+// lower case base32hex encoding 12 bytes, whose first 4 bytes hold a Unix
+// timestamp which isn't in the future.
+func XID(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	args, err := parseArgs("XID", data.SplitArgs(), "skew")
+	if err != nil {
+		return err
+	}
+
+	// 20 characters hold 100 bits, so the last character must have its low 4
+	// bits unset for the ID to be canonical
+	if len(v) != 20 || strings.Trim(v, "0123456789abcdefghijklmnopqrstuv") != "" || strings.IndexByte("0g", v[19]) < 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid XID",
+		}
+	}
+
+	b, err := xidEncoding.DecodeString(v)
+	if err != nil || len(b) != 12 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid XID",
+		}
+	}
+
+	return checkTime(data, args, time.Unix(int64(binary.BigEndian.Uint32(b[:4])), 0))
+}
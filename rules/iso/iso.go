@@ -0,0 +1,249 @@
+// Package iso contains rules for ISO standard codes: ISO 3166-1 countries,
+// ISO 3166-2 subdivisions, ISO 639 languages and BCP 47 language tags. ISO
+// 4217 currency codes are checked by the Currency rule in rules/finance.
+package iso
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Country", Country)
+	rules.Add("Subdivision", Subdivision)
+	rules.Add("Language", Language)
+	rules.Add("LanguageTag", LanguageTag)
+
+	for _, fields := range readTable(strings.NewReader(countryTable)) {
+		alpha2[fields[0]] = struct{}{}
+		alpha3[fields[1]] = struct{}{}
+		numeric[fields[2]] = struct{}{}
+	}
+
+	for _, fields := range readTable(strings.NewReader(languageTable)) {
+		if fields[0] != "-" {
+			languages[fields[0]] = struct{}{}
+		}
+		for _, code := range fields[1:] {
+			languages3[code] = struct{}{}
+		}
+	}
+
+	if err := LoadSubdivisions(strings.NewReader(subdivisionTable)); err != nil {
+		panic(err)
+	}
+}
+
+var (
+	//go:embed countries.txt
+	countryTable string
+	//go:embed languages.txt
+	languageTable string
+	//go:embed subdivisions.txt
+	subdivisionTable string
+)
+
+var (
+	alpha2     = map[string]struct{}{}
+	alpha3     = map[string]struct{}{}
+	numeric    = map[string]struct{}{}
+	languages  = map[string]struct{}{}
+	languages3 = map[string]struct{}{}
+
+	// Subdivision codes keyed by country. Countries without an entry have no
+	// subdivisions.
+	subdivisions = map[string]map[string]struct{}{}
+	mu           sync.RWMutex
+
+	rxSubdivision = regexp.MustCompile(`^([A-Z]{2})-([A-Z0-9]{1,3})$`)
+)
+
+// Returns the whitespace separated fields of each line, skipping blank lines
+// and comments
+func readTable(r io.Reader) [][]string {
+	var table [][]string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			table = append(table, strings.Fields(line))
+		}
+	}
+	return table
+}
+
+// Loads ISO 3166-2 subdivision codes for the Subdivision rule, such as codes
+// added to the standard after the embedded list was generated. Each line
+// holds a country followed by the codes of its subdivisions, without the
+// country prefix:
+//
+//	CA AB BC MB NB NL NS NT NU ON PE QC SK YT
+//
+// Each country listed has all of its codes replaced, so a line must list
+// every subdivision of its country. Countries which aren't listed keep their
+// codes. If any line is invalid nothing is loaded.
+func LoadSubdivisions(r io.Reader) error {
+	loaded := map[string]map[string]struct{}{}
+	for _, fields := range readTable(r) {
+		if _, ok := alpha2[fields[0]]; !ok {
+			return fmt.Errorf("Unknown country '%s' in subdivisions", fields[0])
+		}
+
+		codes := map[string]struct{}{}
+		for _, code := range fields[1:] {
+			if !rxSubdivision.MatchString(fields[0] + "-" + code) {
+				return fmt.Errorf("Invalid subdivision '%s' for country '%s'", code, fields[0])
+			}
+			codes[code] = struct{}{}
+		}
+		loaded[fields[0]] = codes
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for country, codes := range loaded {
+		subdivisions[country] = codes
+	}
+	return nil
+}
+
+// Passes if the data is an ISO 3166-1 country code. By default this is an
+// upper case alpha-2 code such as "GB". Takes an optional argument:
+//
+//	Country:alpha3   an upper case alpha-3 code, such as "GBR"
+//	Country:numeric  a numeric code, such as "826" or 826
+func Country(data rules.ValidationData) error {
+	format := "alpha2"
+	if len(data.Args) > 0 {
+		format = data.Args[0]
+	}
+
+	var codes map[string]struct{}
+	var v string
+	switch format {
+	case "alpha2", "alpha3":
+		codes = alpha2
+		if format == "alpha3" {
+			codes = alpha3
+		}
+
+		var err error
+		if v, err = helper.ToString(data.Value); err != nil {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "is not a string",
+			}
+		}
+	case "numeric":
+		codes = numeric
+		if helper.IsInt(data.Value) || helper.IsUint(data.Value) {
+			n, err := helper.ToBigFloat(data.Value)
+			if err != nil {
+				return err
+			}
+			i, _ := n.Int64()
+			v = fmt.Sprintf("%03d", i)
+		} else {
+			var err error
+			if v, err = helper.ToString(data.Value); err != nil {
+				return rules.ErrInvalid{
+					ValidationData: data,
+					Failure:        "is not a string or integer",
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("Unknown Country argument '%s': expected alpha2, alpha3 or numeric", format)
+	}
+
+	if _, ok := codes[v]; !ok {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ISO 3166-1 country code",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is an ISO 3166-2 subdivision code, such as "US-CA" or
+// "CA-QC". Codes are checked against an embedded copy of the full ISO 3166-2
+// list, so codes for countries without subdivisions always fail. Use
+// LoadSubdivisions to add codes which are newer than the embedded list.
+func Subdivision(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !IsSubdivision(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ISO 3166-2 subdivision code",
+		}
+	}
+
+	return nil
+}
+
+// Reports whether str is a valid ISO 3166-2 subdivision code. See Subdivision.
+func IsSubdivision(str string) bool {
+	match := rxSubdivision.FindStringSubmatch(str)
+	if match == nil {
+		return false
+	}
+
+	if _, ok := alpha2[match[1]]; !ok {
+		return false
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	_, ok := subdivisions[match[1]][match[2]]
+	return ok
+}
+
+// Passes if the data is a lower case ISO 639-1 language code, such as "en".
+// 'Language:alpha3' accepts ISO 639-2 codes instead, such as "eng" or "haw",
+// including bibliographic codes such as "ger".
+func Language(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	codes := languages
+	if len(data.Args) > 0 {
+		switch data.Args[0] {
+		case "alpha2":
+		case "alpha3":
+			codes = languages3
+		default:
+			return fmt.Errorf("Unknown Language argument '%s': expected alpha2 or alpha3", data.Args[0])
+		}
+	}
+
+	if _, ok := codes[v]; !ok {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ISO 639 language code",
+		}
+	}
+
+	return nil
+}
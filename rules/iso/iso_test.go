@@ -0,0 +1,85 @@
+package iso
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestISO(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{Country, "Country", nil, []interface{}{"GB", "US", "AX", "SS"}, []interface{}{"ZZ", "UK", "gb", "GBR", "", 826}},
+		{Country, "Country", []string{"alpha3"}, []interface{}{"GBR", "USA", "DEU"}, []interface{}{"GB", "ZZZ", "gbr"}},
+		{Country, "Country", []string{"numeric"}, []interface{}{"826", "004", 826, uint16(4), 4}, []interface{}{"4", "999", 999, -4, "GB"}},
+		{Subdivision, "Subdivision", nil, []interface{}{"US-CA", "CA-QC", "GB-ENG", "GB-LND", "FR-IDF", "JP-13", "AU-NSW"}, []interface{}{"GB-ZZZ", "JP-99", "AQ-01", "US-ZZ", "CA-XX", "ZZ-AB", "US", "us-ca", "US-CALI", "USCA"}},
+		{Language, "Language", nil, []interface{}{"en", "fr", "zh", "he"}, []interface{}{"EN", "xx", "eng", "iw", ""}},
+		{Language, "Language", []string{"alpha3"}, []interface{}{"eng", "deu", "ger", "zho", "chi", "haw", "ace", "tib", "mul"}, []interface{}{"en", "xxx", "ENG", "qaa", "-"}},
+		{LanguageTag, "LanguageTag", nil, []interface{}{
+			"en", "en-GB", "en-gb", "zh-Hant-TW", "es-419", "sr-Latn-RS", "de-CH-1996", "sl-rozaj-biske",
+			"zh-yue-HK", "en-US-u-ca-gregory", "en-a-bbb-x-a-ccc", "x-private", "i-klingon", "yue", "hy-Latn-IT-arevela",
+		}, []interface{}{
+			"", "e", "xx", "en-ZZ", "en-UK", "en--GB", "en_GB", "en-GB-", "de-DE-1996-1996", "en-a-bbb-a-ccc",
+			"en-a", "en-x", "toolongtag", "en-Latn-Latn", "123",
+		}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %v: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %v to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
+
+func TestLoadSubdivisions(t *testing.T) {
+	mu.RLock()
+	embedded := subdivisions["FR"]
+	mu.RUnlock()
+	defer func() {
+		mu.Lock()
+		subdivisions["FR"] = embedded
+		mu.Unlock()
+	}()
+
+	// Nothing is loaded if any line is invalid
+	if err := LoadSubdivisions(strings.NewReader("FR 75C IDF\nZZ AB\n")); err == nil {
+		t.Errorf("Expected an unknown country to return an error")
+	}
+	if err := LoadSubdivisions(strings.NewReader("FR 75C ILE-DE-FRANCE\n")); err == nil {
+		t.Errorf("Expected an invalid code to return an error")
+	}
+	if IsSubdivision("FR-75C") || !IsSubdivision("FR-69") {
+		t.Errorf("Expected a failed load to leave the subdivisions unchanged")
+	}
+
+	if err := LoadSubdivisions(strings.NewReader("FR 75C IDF\n")); err != nil {
+		t.Fatalf("Unexpected error loading subdivisions: %s", err)
+	}
+	if !IsSubdivision("FR-75C") || IsSubdivision("FR-69") {
+		t.Errorf("Expected loaded subdivisions to replace the embedded ones")
+	}
+	if !IsSubdivision("US-CA") {
+		t.Errorf("Expected countries which weren't loaded to keep their subdivisions")
+	}
+}
@@ -0,0 +1,161 @@
+package iso
+
+import (
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// Tags registered before RFC 4646 which don't follow the usual syntax
+var grandfathered = map[string]struct{}{
+	"en-gb-oed": {}, "i-ami": {}, "i-bnn": {}, "i-default": {}, "i-enochian": {},
+	"i-hak": {}, "i-klingon": {}, "i-lux": {}, "i-mingo": {}, "i-navajo": {},
+	"i-pwn": {}, "i-tao": {}, "i-tay": {}, "i-tsu": {}, "sgn-be-fr": {},
+	"sgn-be-nl": {}, "sgn-ch-de": {}, "art-lojban": {}, "cel-gaulish": {},
+	"no-bok": {}, "no-nyn": {}, "zh-guoyu": {}, "zh-hakka": {}, "zh-min": {},
+	"zh-min-nan": {}, "zh-xiang": {},
+}
+
+// Passes if the data is a well-formed BCP 47 language tag, such as "en",
+// "en-GB", "zh-Hant-TW" or "es-419". Two letter languages must be ISO 639-1
+// codes and two letter regions must be ISO 3166-1 codes. Tags are case
+// insensitive.
+func LanguageTag(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !IsLanguageTag(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid BCP 47 language tag",
+		}
+	}
+
+	return nil
+}
+
+// Reports whether str is a well-formed BCP 47 language tag, following the
+// syntax of RFC 5646 section 2.1:
+//
+//	language["-" script]["-" region]*("-" variant)*("-" extension)["-" privateuse]
+func IsLanguageTag(str string) bool {
+	str = strings.ToLower(str)
+	if _, ok := grandfathered[str]; ok {
+		return true
+	}
+
+	subtags := strings.Split(str, "-")
+	for _, s := range subtags {
+		if len(s) < 1 || len(s) > 8 || !isAlnum(s) {
+			return false
+		}
+	}
+
+	if subtags[0] == "x" {
+		return len(subtags) > 1
+	}
+
+	// The language: 2-3 letters optionally followed by up to 3 extended
+	// language subtags, or a reserved or registered language of 4-8 letters
+	lang := subtags[0]
+	if !isAlpha(lang) || len(lang) < 2 {
+		return false
+	}
+	if len(lang) == 2 {
+		if _, ok := languages[lang]; !ok {
+			return false
+		}
+	}
+	subtags = subtags[1:]
+
+	if len(lang) <= 3 {
+		for i := 0; i < 3 && len(subtags) > 0 && len(subtags[0]) == 3 && isAlpha(subtags[0]); i++ {
+			subtags = subtags[1:]
+		}
+	}
+
+	if len(subtags) > 0 && len(subtags[0]) == 4 && isAlpha(subtags[0]) {
+		subtags = subtags[1:]
+	}
+
+	if len(subtags) > 0 {
+		if region := subtags[0]; len(region) == 2 && isAlpha(region) {
+			if _, ok := alpha2[strings.ToUpper(region)]; !ok {
+				return false
+			}
+			subtags = subtags[1:]
+		} else if len(region) == 3 && isDigits(region) {
+			subtags = subtags[1:]
+		}
+	}
+
+	variants := map[string]struct{}{}
+	for len(subtags) > 0 && isVariant(subtags[0]) {
+		if _, ok := variants[subtags[0]]; ok {
+			return false
+		}
+		variants[subtags[0]] = struct{}{}
+		subtags = subtags[1:]
+	}
+
+	singletons := map[string]struct{}{}
+	for len(subtags) > 0 && len(subtags[0]) == 1 && subtags[0] != "x" {
+		if _, ok := singletons[subtags[0]]; ok {
+			return false
+		}
+		singletons[subtags[0]] = struct{}{}
+		subtags = subtags[1:]
+
+		n := 0
+		for ; len(subtags) > 0 && len(subtags[0]) >= 2; n++ {
+			subtags = subtags[1:]
+		}
+		if n == 0 {
+			return false
+		}
+	}
+
+	if len(subtags) > 0 && subtags[0] == "x" {
+		return len(subtags) > 1
+	}
+
+	return len(subtags) == 0
+}
+
+// Variants are 5-8 characters, or 4 starting with a digit
+func isVariant(s string) bool {
+	return len(s) >= 5 || (len(s) == 4 && s[0] >= '0' && s[0] <= '9')
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'a' || s[i] > 'z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
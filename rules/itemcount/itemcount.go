@@ -0,0 +1,68 @@
+package itemcount
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("ItemCount", ItemCount)
+}
+
+// Used to check the number of items in a slice, array or map. This takes
+// either an exact count ('ItemCount:5') or an inclusive range
+// ('ItemCount:1,50'). Failures are returned as a rules.ErrCount.
+func ItemCount(data rules.ValidationData) error {
+	n, err := helper.ToLen(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a slice, array or map",
+		}
+	}
+
+	// We should always be provided with a count to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'ItemCount:5')")
+	}
+
+	// Typecast our arguments and test
+	var min, max int
+	bounds := strings.SplitN(data.Args[0], ",", 2)
+	if min, err = strconv.Atoi(strings.TrimSpace(bounds[0])); err != nil {
+		return err
+	}
+	max = min
+	if len(bounds) == 2 {
+		if max, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+			return err
+		}
+	}
+
+	if min < 0 || max < min {
+		return fmt.Errorf("Invalid ItemCount range '%s': expected 0 <= min <= max", data.Args[0])
+	}
+
+	if n >= min && n <= max {
+		return nil
+	}
+
+	failure := fmt.Sprintf("must have %d items but has %d", min, n)
+	if min != max {
+		failure = fmt.Sprintf("must have between %d and %d items but has %d", min, max, n)
+	}
+
+	return rules.ErrCount{
+		ErrInvalid: rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        failure,
+		},
+		Count: n,
+		Min:   min,
+		Max:   max,
+	}
+}
@@ -27,13 +27,25 @@ func Length(data rules.ValidationData) error {
 		return fmt.Errorf("No argument found in the validation struct (eg 'Length:5')")
 	}
 
-	// Typecast our argument and test
+	// Typecast our argument and test. An optional second argument sets the
+	// unit to count in, eg. 'Length:5:runes'.
+	args := data.SplitArgs()
 	var length int
-	if length, err = strconv.Atoi(data.Args[0]); err != nil {
+	if length, err = strconv.Atoi(args[0]); err != nil {
 		return err
 	}
 
-	if len(v) != length {
+	var unit string
+	if len(args) > 1 {
+		unit = args[1]
+	}
+
+	n, err := data.Options.Length(v, unit)
+	if err != nil {
+		return err
+	}
+
+	if n != length {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        fmt.Sprintf("must be %d characters long", length),
@@ -2,7 +2,6 @@ package lessthan
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/tonyhb/govalidate/helper"
 	"github.com/tonyhb/govalidate/rules"
@@ -12,12 +11,12 @@ func init() {
 	rules.Add("LessThan", LessThan)
 }
 
-// Passes if the data is a float/int and is less than the specified integer.
-// Note that this is *not* a less than or equals check, and this only comapres
-// floats/ints to a predefined integer specified in your tag.
-// Fails if the data is not a float/int or the data is less than or equals the comparator
+// Passes if the data is numeric and is strictly less than the number specified
+// in your tag. Note that this is *not* a less than or equals check; use
+// LessThanOrEqual for that.
+// Fails if the data is not numeric or the data is greater than or equal to the comparator
 func LessThan(data rules.ValidationData) error {
-	v, err := helper.ToFloat64(data.Value)
+	v, err := helper.ToBigFloat(data.Value)
 	if err != nil {
 		return rules.ErrInvalid{
 			ValidationData: data,
@@ -25,21 +24,21 @@ func LessThan(data rules.ValidationData) error {
 		}
 	}
 
-	// We should always be provided with a length to validate against
+	// We should always be provided with a number to validate against
 	if len(data.Args) == 0 {
 		return fmt.Errorf("No argument found in the validation struct (eg 'LessThan:5')")
 	}
 
 	// Typecast our argument and test
-	var max float64
-	if max, err = strconv.ParseFloat(data.Args[0], 64); err != nil {
+	max, err := helper.ParseNumber(data.Args[0])
+	if err != nil {
 		return err
 	}
 
-	if v > max {
+	if v.Cmp(max) >= 0 {
 		return rules.ErrInvalid{
 			ValidationData: data,
-			Failure:        fmt.Sprintf("must be less than %d", max),
+			Failure:        fmt.Sprintf("must be less than %s", data.Args[0]),
 		}
 	}
 
@@ -0,0 +1,45 @@
+package lessthanorequal
+
+import (
+	"fmt"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("LessThanOrEqual", LessThanOrEqual)
+}
+
+// Passes if the data is numeric and is less than or equal to the number
+// specified in your tag.
+// Fails if the data is not numeric or the data is greater than the comparator
+func LessThanOrEqual(data rules.ValidationData) error {
+	v, err := helper.ToBigFloat(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not numeric",
+		}
+	}
+
+	// We should always be provided with a number to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'LessThanOrEqual:5')")
+	}
+
+	// Typecast our argument and test
+	max, err := helper.ParseNumber(data.Args[0])
+	if err != nil {
+		return err
+	}
+
+	if v.Cmp(max) > 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must be less than or equal to %s", data.Args[0]),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package maxitems
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("MaxItems", MaxItems)
+}
+
+// Used to check whether a slice, array or map has at most N items.
+// Fails if the data isn't a collection or has more items than the comparator.
+// Failures are returned as a rules.ErrCount.
+func MaxItems(data rules.ValidationData) error {
+	n, err := helper.ToLen(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a slice, array or map",
+		}
+	}
+
+	// We should always be provided with a count to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'MaxItems:5')")
+	}
+
+	// Typecast our argument and test
+	var max int
+	if max, err = strconv.Atoi(data.Args[0]); err != nil {
+		return err
+	}
+
+	if n > max {
+		return rules.ErrCount{
+			ErrInvalid: rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        fmt.Sprintf("has too many items; it must have at most %d but has %d", max, n),
+			},
+			Count: n,
+			Min:   0,
+			Max:   max,
+		}
+	}
+
+	return nil
+}
@@ -28,14 +28,25 @@ func MaxLength(data rules.ValidationData) error {
 		return fmt.Errorf("No argument found in the validation struct (eg 'MaxLength:5')")
 	}
 
-	// Typecast our argument and test
+	// Typecast our argument and test. An optional second argument sets the
+	// unit to count in, eg. 'MaxLength:5:runes'.
+	args := data.SplitArgs()
 	var max int
-	if max, err = strconv.Atoi(data.Args[0]); err != nil {
+	if max, err = strconv.Atoi(args[0]); err != nil {
 		return err
 	}
-	// Typecast our argument and test
 
-	if len(v) > max {
+	var unit string
+	if len(args) > 1 {
+		unit = args[1]
+	}
+
+	n, err := data.Options.Length(v, unit)
+	if err != nil {
+		return err
+	}
+
+	if n > max {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        fmt.Sprintf("is too long; it must be at most %d characters long", max),
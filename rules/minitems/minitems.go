@@ -0,0 +1,51 @@
+package minitems
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("MinItems", MinItems)
+}
+
+// Used to check whether a slice, array or map has at least N items.
+// Fails if the data isn't a collection or has fewer items than the comparator.
+// Failures are returned as a rules.ErrCount.
+func MinItems(data rules.ValidationData) error {
+	n, err := helper.ToLen(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a slice, array or map",
+		}
+	}
+
+	// We should always be provided with a count to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'MinItems:5')")
+	}
+
+	// Typecast our argument and test
+	var min int
+	if min, err = strconv.Atoi(data.Args[0]); err != nil {
+		return err
+	}
+
+	if n < min {
+		return rules.ErrCount{
+			ErrInvalid: rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        fmt.Sprintf("has too few items; it must have at least %d but has %d", min, n),
+			},
+			Count: n,
+			Min:   min,
+			Max:   -1,
+		}
+	}
+
+	return nil
+}
@@ -28,13 +28,25 @@ func MinLength(data rules.ValidationData) error {
 		return fmt.Errorf("No argument found in the validation struct (eg 'MinLength:5')")
 	}
 
-	// Typecast our argument and test
+	// Typecast our argument and test. An optional second argument sets the
+	// unit to count in, eg. 'MinLength:5:runes'.
+	args := data.SplitArgs()
 	var min int
-	if min, err = strconv.Atoi(data.Args[0]); err != nil {
+	if min, err = strconv.Atoi(args[0]); err != nil {
 		return err
 	}
 
-	if len(v) < min {
+	var unit string
+	if len(args) > 1 {
+		unit = args[1]
+	}
+
+	n, err := data.Options.Length(v, unit)
+	if err != nil {
+		return err
+	}
+
+	if n < min {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        fmt.Sprintf("is too short; it must be at least %d characters long", min),
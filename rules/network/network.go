@@ -0,0 +1,336 @@
+// Package network contains rules for validating network addresses: IP
+// addresses, CIDR prefixes, MAC addresses, ports and host:port pairs.
+//
+// As well as strings, the IP rules accept net.IP and netip.Addr fields and the
+// CIDR rule accepts net.IPNet and netip.Prefix fields.
+package network
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("IP", IP)
+	rules.Add("IPv4", IPv4)
+	rules.Add("IPv6", IPv6)
+	rules.Add("CIDR", CIDR)
+	rules.Add("MAC", MAC)
+	rules.Add("Port", Port)
+	rules.Add("HostPort", HostPort)
+}
+
+// Passes if the data is an IPv4 or IPv6 address. An optional argument
+// restricts the address to a range:
+//
+//	IP:public    a globally routable unicast address, outside the
+//	             special-purpose ranges of RFC 6890
+//	IP:private   an RFC 1918 or RFC 4193 private address
+//	IP:loopback  127.0.0.0/8 or ::1
+func IP(data rules.ValidationData) error {
+	return checkIP(data, "")
+}
+
+// Passes if the data is an IPv4 address. This takes the same range arguments
+// as IP, eg. 'IPv4:private'.
+func IPv4(data rules.ValidationData) error {
+	return checkIP(data, "IPv4")
+}
+
+// Passes if the data is an IPv6 address. This takes the same range arguments
+// as IP, eg. 'IPv6:public'.
+func IPv6(data rules.ValidationData) error {
+	return checkIP(data, "IPv6")
+}
+
+func checkIP(data rules.ValidationData, version string) error {
+	addr, ok := toAddr(data.Value)
+	if !ok {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid IP address",
+		}
+	}
+
+	if version == "IPv4" && !addr.Is4() {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not an IPv4 address",
+		}
+	}
+	if version == "IPv6" && !addr.Is6() {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not an IPv6 address",
+		}
+	}
+
+	if len(data.Args) == 0 {
+		return nil
+	}
+
+	// Check IPv4-mapped IPv6 addresses as the IPv4 address they hold
+	addr = addr.Unmap()
+
+	var valid bool
+	switch data.Args[0] {
+	case "public":
+		valid = isPublic(addr)
+	case "private":
+		valid = addr.IsPrivate()
+	case "loopback":
+		valid = addr.IsLoopback()
+	default:
+		return fmt.Errorf("Unknown IP range '%s' (eg 'IP:public', 'IP:private' or 'IP:loopback')", data.Args[0])
+	}
+
+	if !valid {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a %s IP address", data.Args[0]),
+		}
+	}
+
+	return nil
+}
+
+// The special-purpose ranges from the IANA registries (RFC 6890 and its
+// updates) which aren't globally reachable, other than those covered by
+// netip's private, loopback and link-local checks.
+var special = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("192.88.99.0/24"),  // deprecated 6to4 relay anycast
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, and the broadcast address
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use NAT64
+	netip.MustParsePrefix("100::/64"),        // discard-only
+	netip.MustParsePrefix("2001::/23"),       // IETF protocol assignments
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+	netip.MustParsePrefix("2002::/16"),       // 6to4
+	netip.MustParsePrefix("3fff::/20"),       // documentation
+	netip.MustParsePrefix("5f00::/16"),       // segment routing SIDs
+}
+
+// Reports whether an address is globally routable.
+func isPublic(addr netip.Addr) bool {
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+		return false
+	}
+	for _, prefix := range special {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Converts a net.IP, netip.Addr or string into a netip.Addr.
+func toAddr(data interface{}) (netip.Addr, bool) {
+	switch v := data.(type) {
+	case netip.Addr:
+		return v, v.IsValid()
+	case net.IP:
+		addr, ok := netip.AddrFromSlice(v)
+		// net.IP stores IPv4 addresses in 16 bytes
+		return addr.Unmap(), ok
+	}
+
+	str, err := helper.ToString(data)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	addr, err := netip.ParseAddr(str)
+	return addr, err == nil
+}
+
+// Passes if the data is an IP prefix in CIDR notation, such as "10.0.0.0/8" or
+// "2001:db8::/32". Use 'CIDR:v4' or 'CIDR:v6' to require a version.
+//
+// By default the address may have bits set after the prefix ("10.1.2.3/8"),
+// as accepted by net.ParseCIDR. Use 'CIDR:strict' to require these to be zero.
+// Arguments can be combined, eg. 'CIDR:v4:strict'.
+func CIDR(data rules.ValidationData) error {
+	var (
+		prefix netip.Prefix
+		ok     bool
+	)
+
+	switch v := data.Value.(type) {
+	case netip.Prefix:
+		prefix, ok = v, v.IsValid()
+	case net.IPNet:
+		prefix, ok = ipNetToPrefix(&v)
+	case *net.IPNet:
+		if v != nil {
+			prefix, ok = ipNetToPrefix(v)
+		}
+	default:
+		if str, err := helper.ToString(data.Value); err == nil {
+			p, err := netip.ParsePrefix(str)
+			prefix, ok = p, err == nil
+		}
+	}
+
+	if !ok {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid CIDR prefix",
+		}
+	}
+
+	for _, arg := range data.SplitArgs() {
+		var failure string
+		switch arg {
+		case "v4":
+			if !prefix.Addr().Is4() {
+				failure = "is not an IPv4 CIDR prefix"
+			}
+		case "v6":
+			if !prefix.Addr().Is6() {
+				failure = "is not an IPv6 CIDR prefix"
+			}
+		case "strict":
+			if prefix.Masked() != prefix {
+				failure = fmt.Sprintf("has bits set after the prefix; it should be %s", prefix.Masked())
+			}
+		default:
+			return fmt.Errorf("Unknown CIDR argument '%s' (eg 'CIDR:v4:strict')", arg)
+		}
+
+		if failure != "" {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        failure,
+			}
+		}
+	}
+
+	return nil
+}
+
+func ipNetToPrefix(n *net.IPNet) (netip.Prefix, bool) {
+	addr, ok := netip.AddrFromSlice(n.IP)
+	if !ok {
+		return netip.Prefix{}, false
+	}
+	ones, bits := n.Mask.Size()
+	if bits == 0 {
+		return netip.Prefix{}, false
+	}
+	addr = addr.Unmap()
+	if addr.Is4() && bits == 128 {
+		ones -= 96
+	}
+	return netip.PrefixFrom(addr, ones), true
+}
+
+// Passes if the data is a MAC address (IEEE 802 MAC-48, EUI-48, EUI-64 or a
+// 20-octet IP over InfiniBand address) in any format accepted by
+// net.ParseMAC, or a non-empty net.HardwareAddr.
+func MAC(data rules.ValidationData) error {
+	if v, ok := data.Value.(net.HardwareAddr); ok {
+		if len(v) > 0 {
+			return nil
+		}
+	} else if str, err := helper.ToString(data.Value); err == nil {
+		if _, err := net.ParseMAC(str); err == nil {
+			return nil
+		}
+	}
+
+	return rules.ErrInvalid{
+		ValidationData: data,
+		Failure:        "is not a valid MAC address",
+	}
+}
+
+// Passes if the data is a TCP/UDP port number between 1 and 65535, given as a
+// number or a string. Use 'Port:0' to also allow port 0, which usually means
+// "pick any free port".
+func Port(data rules.ValidationData) error {
+	min := uint64(1)
+	if len(data.Args) > 0 {
+		if data.Args[0] != "0" {
+			return fmt.Errorf("Unknown Port argument '%s' (eg 'Port:0')", data.Args[0])
+		}
+		min = 0
+	}
+
+	if !isPort(data.Value, min) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a valid port; it must be a number between %d and 65535", min),
+		}
+	}
+
+	return nil
+}
+
+func isPort(data interface{}, min uint64) bool {
+	var str string
+	if n, err := helper.ToBigFloat(data); err == nil {
+		if !n.IsInt() {
+			return false
+		}
+		str = n.Text('f', 0)
+	} else if str, err = helper.ToString(data); err != nil {
+		return false
+	}
+
+	port, err := strconv.ParseUint(str, 10, 16)
+	return err == nil && port >= min
+}
+
+// Passes if the data is a string of the form "host:port", where host is a
+// hostname (which may be internationalized) or IP address (IPv6 addresses
+// must be in brackets, eg. "[::1]:8080") and port is between 1 and 65535.
+func HostPort(data rules.ValidationData) error {
+	str, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	host, port, err := net.SplitHostPort(str)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid host:port",
+		}
+	}
+
+	if !isPort(port, 1) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("has an invalid port '%s'", port),
+		}
+	}
+
+	if _, err := netip.ParseAddr(host); err != nil && !isHostname(host) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("has an invalid host '%s'", host),
+		}
+	}
+
+	return nil
+}
+
+// Reports whether str is a host name, converting internationalized names to
+// ASCII first.
+func isHostname(str string) bool {
+	ascii, err := helper.ToASCIIHostname(str)
+	return err == nil && helper.IsHostname(ascii)
+}
@@ -0,0 +1,88 @@
+package network
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+type test struct {
+	Args    []string
+	Valid   []interface{}
+	Invalid []interface{}
+}
+
+func run(t *testing.T, name string, rule rules.ValidatorFunc, tests []test) {
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %v: %s", name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %v to fail validation", name, test.Args, v)
+			}
+		}
+	}
+}
+
+func TestIP(t *testing.T) {
+	run(t, "IP", IP, []test{
+		{nil, []interface{}{"127.0.0.1", "::1", "2001:db8::1", net.ParseIP("10.0.0.1"), netip.MustParseAddr("8.8.8.8")}, []interface{}{"", "256.0.0.1", "1.2.3", "example.com", net.IP(nil), netip.Addr{}, 1}},
+		{[]string{"public"}, []interface{}{"8.8.8.8", "2606:4700::1111"}, []interface{}{"10.0.0.1", "192.168.1.1", "127.0.0.1", "169.254.0.1", "fd00::1", "0.0.0.0", "224.0.0.1", "0.1.2.3", "100.64.0.1", "100.127.255.254", "192.0.0.8", "192.0.2.1", "198.18.0.1", "198.19.255.255", "198.51.100.7", "203.0.113.9", "240.0.0.1", "255.255.255.255", "::ffff:10.0.0.1", "100::1", "2001:db8::1", "2001::1", "2002:c000:204::1", "3fff::1", "64:ff9b:1::1"}},
+		{[]string{"public"}, []interface{}{"100.63.255.255", "100.128.0.1", "192.0.3.1", "198.17.255.255", "198.20.0.1", "223.255.255.254", "::ffff:8.8.8.8", "64:ff9b::808:808", "2001:4860:4860::8888"}, nil},
+		{[]string{"private"}, []interface{}{"10.1.2.3", "172.16.0.1", "192.168.0.1", "fd12::1", net.ParseIP("192.168.0.1"), "::ffff:10.0.0.1"}, []interface{}{"8.8.8.8", "127.0.0.1", "::ffff:8.8.8.8"}},
+		{[]string{"loopback"}, []interface{}{"127.0.0.1", "127.1.2.3", "::1", "::ffff:127.0.0.1"}, []interface{}{"10.0.0.1", "::2", "::ffff:10.0.0.1"}},
+	})
+
+	run(t, "IPv4", IPv4, []test{
+		{nil, []interface{}{"1.2.3.4", net.ParseIP("1.2.3.4")}, []interface{}{"::1", "::ffff:1.2.3.4", net.ParseIP("::1")}},
+		{[]string{"private"}, []interface{}{"10.0.0.1"}, []interface{}{"fd00::1", "8.8.8.8"}},
+	})
+
+	run(t, "IPv6", IPv6, []test{
+		{nil, []interface{}{"::1", "fe80::1%eth0", netip.MustParseAddr("2001:db8::1")}, []interface{}{"1.2.3.4", net.ParseIP("1.2.3.4")}},
+	})
+}
+
+func TestCIDR(t *testing.T) {
+	_, ipNet, _ := net.ParseCIDR("192.168.0.0/16")
+
+	run(t, "CIDR", CIDR, []test{
+		{nil, []interface{}{"10.0.0.0/8", "10.1.2.3/8", "2001:db8::/32", netip.MustParsePrefix("10.0.0.0/8"), ipNet, *ipNet}, []interface{}{"10.0.0.0", "10.0.0.0/33", "example.com/8", (*net.IPNet)(nil), 8}},
+		{[]string{"v4:strict"}, []interface{}{"10.0.0.0/8", ipNet}, []interface{}{"10.1.2.3/8", "2001:db8::/32"}},
+		{[]string{"v6"}, []interface{}{"2001:db8::/32"}, []interface{}{"10.0.0.0/8"}},
+	})
+}
+
+func TestMAC(t *testing.T) {
+	mac, _ := net.ParseMAC("00:00:5e:00:53:01")
+
+	run(t, "MAC", MAC, []test{
+		{nil, []interface{}{"00:00:5e:00:53:01", "00-00-5E-00-53-01", "0000.5e00.5301", "02:00:5e:10:00:00:00:01", mac}, []interface{}{"00:00:5e:00:53", "00:00:5e:00:53:zz", "", net.HardwareAddr(nil), 1}},
+	})
+}
+
+func TestPort(t *testing.T) {
+	run(t, "Port", Port, []test{
+		{nil, []interface{}{1, 80, uint16(443), "8080", 65535}, []interface{}{0, -1, 65536, 80.5, "http", "", "0"}},
+		{[]string{"0"}, []interface{}{0, "0", 65535}, []interface{}{-1, 65536}},
+	})
+}
+
+func TestHostPort(t *testing.T) {
+	run(t, "HostPort", HostPort, []test{
+		{nil, []interface{}{"example.com:80", "localhost:8080", "bücher.de:443", "127.0.0.1:443", "[::1]:8080", "db-1.internal.:5432"}, []interface{}{"example.com", "example.com:0", "example.com:http", "::1:8080", ":8080", "-bad.com:80", "exa mple.com:80", 80}},
+	})
+}
@@ -12,7 +12,7 @@ func init() {
 // Checks whether a float or int type is 0. This could mean the data is above *or* below 0.
 // Fails if the data isn't a float/int type, or the data is exactly 0.
 func NotZero(data rules.ValidationData) error {
-	v, err := helper.ToFloat64(data.Value)
+	v, err := helper.ToBigFloat(data.Value)
 	if err != nil {
 		return rules.ErrInvalid{
 			ValidationData: data,
@@ -20,7 +20,7 @@ func NotZero(data rules.ValidationData) error {
 		}
 	}
 
-	if v == 0 {
+	if v.Sign() == 0 {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        "is 0",
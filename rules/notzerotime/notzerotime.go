@@ -1,8 +1,7 @@
 package notzerotime
 
 import (
-	"time"
-
+	"github.com/tonyhb/govalidate/helper"
 	"github.com/tonyhb/govalidate/rules"
 )
 
@@ -10,17 +9,18 @@ func init() {
 	rules.Add("NotZeroTime", NotZeroTime)
 }
 
-// Checks whether a float or int type is 0. This could mean the data is above *or* below 0.
-// Fails if the data isn't a float/int type, or the data is exactly 0.
+// Checks whether a time.Time (or *time.Time) has been set.
+// Fails if the data isn't a Time, is a nil *time.Time, or has a zero value.
 func NotZeroTime(data rules.ValidationData) error {
-	if _, ok := data.Value.(time.Time); !ok {
+	t, err := helper.ToTime(data.Value)
+	if err != nil {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        "is not a Time type",
 		}
 	}
 
-	if data.Value.(time.Time).Equal(time.Time{}) == true {
+	if t.IsZero() {
 		return rules.ErrInvalid{
 			ValidationData: data,
 			Failure:        "has a zero value",
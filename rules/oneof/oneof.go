@@ -0,0 +1,211 @@
+package oneof
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("OneOf", OneOf)
+	rules.Add("NotOneOf", NotOneOf)
+}
+
+var (
+	// Named sets of values registered with RegisterEnum
+	enums = map[string]enum{}
+	mu    sync.RWMutex
+)
+
+// A registered enum. values are compared with the data and names are used in
+// failure messages; they only differ for numeric values, such as an int type
+// with a String method.
+type enum struct {
+	values []string
+	names  []string
+}
+
+// Registers a named set of values which can be used in OneOf and NotOneOf tags
+// instead of listing the values in every tag:
+//
+//	oneof.RegisterEnum("Status", "draft", "published", "archived")
+//
+//	type Post struct {
+//		Status string `validate:"OneOf:enum=Status"`
+//	}
+//
+// Values of numeric kinds are compared numerically, so an int type with a
+// String method matches fields of that type. Other values are converted to
+// strings using helper.ToString, so typed string and fmt.Stringer constants
+// can be used directly. If an enum with the same name already exists this will
+// return an error.
+func RegisterEnum(name string, values ...interface{}) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := enums[name]; ok {
+		return fmt.Errorf("Enum '%s' already exists", name)
+	}
+
+	e := enum{
+		values: make([]string, len(values)),
+		names:  make([]string, len(values)),
+	}
+	for i, v := range values {
+		str, err := helper.ToString(v)
+		if err != nil {
+			str = fmt.Sprint(v)
+		}
+		e.names[i] = str
+		e.values[i] = str
+		if n, ok := number(v); ok {
+			e.values[i] = n
+		}
+	}
+
+	enums[name] = e
+	return nil
+}
+
+// Formats values of numeric kinds as they'd be written in a tag
+func number(v interface{}) (string, bool) {
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// Passes if the data is equal to one of the values in the tag. Values are
+// separated by '|', and an optional 'nocase' argument makes the comparison
+// case-insensitive using Unicode case folding:
+//
+//	OneOf:draft|published|archived
+//	OneOf:draft|published|archived:nocase
+//	OneOf:1|2|3
+//	OneOf:enum=Status
+//
+// Strings are compared exactly (or case-insensitively); numeric data is
+// compared numerically so that `OneOf:1|2|3` matches 2.0.
+// Fails if the data is not a string or number or isn't one of the values.
+func OneOf(data rules.ValidationData) error {
+	values, found, err := match(data)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "must be one of " + list(values),
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is not equal to any of the values in the tag. This takes
+// the same arguments as OneOf:
+//
+//	NotOneOf:admin|root:nocase
+//
+// Fails if the data is not a string or number or is one of the values.
+func NotOneOf(data rules.ValidationData) error {
+	values, found, err := match(data)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "must not be one of " + list(values),
+		}
+	}
+
+	return nil
+}
+
+// Parses the tag's arguments and returns the names of the allowed values for
+// failure messages and whether the data matches any of them. Invalid data is
+// returned as an ErrInvalid error.
+func match(data rules.ValidationData) (names []string, found bool, err error) {
+	var values []string
+
+	// We should always be provided with values to validate against
+	if len(data.Args) == 0 {
+		return nil, false, fmt.Errorf("No argument found in the validation struct (eg 'OneOf:a|b|c')")
+	}
+
+	args := data.SplitArgs()
+	nocase := false
+	for _, arg := range args[1:] {
+		if arg != "nocase" {
+			return nil, false, fmt.Errorf("Unknown argument '%s' (eg 'OneOf:a|b|c:nocase')", arg)
+		}
+		nocase = true
+	}
+
+	if strings.HasPrefix(args[0], "enum=") {
+		name := strings.TrimPrefix(args[0], "enum=")
+
+		mu.RLock()
+		e, ok := enums[name]
+		mu.RUnlock()
+
+		if !ok {
+			return nil, false, fmt.Errorf("No enum named '%s' has been registered", name)
+		}
+		values, names = e.values, e.names
+	} else {
+		values = strings.Split(args[0], "|")
+		names = values
+	}
+
+	if v, err := helper.ToBigFloat(data.Value); err == nil {
+		for _, value := range values {
+			if n, err := helper.ParseNumber(value); err == nil && n.Cmp(v) == 0 {
+				return names, true, nil
+			}
+		}
+		return names, false, nil
+	}
+
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return nil, false, rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string or number",
+		}
+	}
+
+	for _, value := range values {
+		if v == value || (nocase && strings.EqualFold(v, value)) {
+			return names, true, nil
+		}
+	}
+
+	return names, false, nil
+}
+
+// Lists values for failure messages, eg. "'draft', 'published' or 'archived'"
+func list(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "'" + v + "'"
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
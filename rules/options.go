@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/tonyhb/govalidate/helper"
+)
+
+// Units for measuring the length of strings. Length rules accept these as an
+// optional trailing argument, eg. `MaxLength:10:runes`.
+const (
+	// Counts bytes of UTF-8. This is the default.
+	Bytes = "bytes"
+	// Counts Unicode code points, so "café" is 4 long.
+	Runes = "runes"
+	// Counts user-perceived characters, so a flag emoji or a letter with a
+	// combining accent is 1 long.
+	Graphemes = "graphemes"
+)
+
+// Options which apply to every rule run by a validator. Rules read these from
+// ValidationData.Options; arguments in a tag always override them.
+type Options struct {
+	// The unit used by length rules when the tag doesn't specify one. If this
+	// is empty lengths are counted in bytes.
+	LengthUnit string
+
+	// Returns the current time for rules which compare against it. If this
+	// is nil time.Now is used. Set this to a fixed time to make validation
+	// deterministic in tests.
+	Now func() time.Time
+
+	// The filesystem used by rules which check that paths exist. If this is
+	// nil the local filesystem is used. Paths are relative to the root of
+	// the filesystem, so tests can use an fstest.MapFS.
+	FS fs.FS
+}
+
+// Returns the current time using the Now option.
+func (o Options) Time() time.Time {
+	if o.Now != nil {
+		return o.Now()
+	}
+	return time.Now()
+}
+
+// Returns information about the named file using the FS option. With an FS,
+// a leading "/" is ignored and the path is cleaned before use.
+func (o Options) Stat(name string) (fs.FileInfo, error) {
+	if o.FS == nil {
+		return os.Stat(name)
+	}
+
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		name = "."
+	}
+	return fs.Stat(o.FS, name)
+}
+
+// Returns the length of str in the given unit, falling back to the
+// LengthUnit option and then to bytes if unit is empty.
+func (o Options) Length(str, unit string) (int, error) {
+	if unit == "" {
+		unit = o.LengthUnit
+	}
+
+	switch strings.ToLower(unit) {
+	case "", Bytes:
+		return len(str), nil
+	case Runes:
+		return helper.RuneCount(str), nil
+	case Graphemes:
+		return helper.GraphemeCount(str), nil
+	}
+
+	return 0, fmt.Errorf("Unknown length unit '%s'", unit)
+}
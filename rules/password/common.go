@@ -0,0 +1,60 @@
+package password
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed common_passwords.txt
+var commonList string
+
+var (
+	// Common passwords and their rank, with the most common being 0
+	common = map[string]int{}
+
+	// The length of the longest common password, to limit searches in Entropy
+	longestCommon int
+
+	commonMu sync.RWMutex
+)
+
+func init() {
+	scanner := bufio.NewScanner(strings.NewReader(commonList))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			AddCommonPasswords(line)
+		}
+	}
+}
+
+// Adds passwords to the list of common passwords rejected by policies with
+// NoCommon set, and used to estimate entropy. Passwords added later are
+// treated as less common.
+func AddCommonPasswords(passwords ...string) {
+	commonMu.Lock()
+	defer commonMu.Unlock()
+
+	for _, p := range passwords {
+		p = strings.ToLower(p)
+		if _, ok := common[p]; ok {
+			continue
+		}
+
+		common[p] = len(common)
+		if n := len([]rune(p)); n > longestCommon {
+			longestCommon = n
+		}
+	}
+}
+
+// Reports whether a password is in the list of common passwords, ignoring
+// case.
+func IsCommon(password string) bool {
+	commonMu.RLock()
+	defer commonMu.RUnlock()
+
+	_, ok := common[strings.ToLower(password)]
+	return ok
+}
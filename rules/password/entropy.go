@@ -0,0 +1,182 @@
+package password
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Rows of a QWERTY keyboard, used to find keyboard walks such as "asdf"
+var keyboardRows = []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// Estimates the entropy of a password in bits, in the style of zxcvbn: the
+// password is split into the patterns an attacker would try first, and the
+// bits needed to guess each pattern are added up. The patterns are:
+//
+//   - common passwords, scored by their rank in the list
+//   - repeated characters, such as "aaaa"
+//   - sequences, such as "abcd" or "9876"
+//   - keyboard walks, such as "qwerty" or "asdf"
+//
+// Any other character scores the bits of a random character from the
+// character classes used in the password. This is a simplified estimate and
+// gives higher scores than zxcvbn for passwords made of dictionary words.
+func Entropy(password string) float64 {
+	runes := []rune(password)
+	lower := []rune(strings.ToLower(password))
+	if len(lower) != len(runes) {
+		// Lower casing changed the number of runes, so patterns can't be
+		// matched against the original
+		lower = runes
+	}
+
+	perChar := math.Log2(float64(poolSize(runes)))
+
+	commonMu.RLock()
+	defer commonMu.RUnlock()
+
+	total := 0.0
+	for i := 0; i < len(runes); {
+		length, bits := 1, perChar
+		for _, match := range []func([]rune, []rune, int, float64) (int, float64){matchCommon, matchRepeat, matchSequence, matchKeyboard} {
+			if l, b := match(runes, lower, i, perChar); l > length || (l == length && l > 1 && b < bits) {
+				length, bits = l, b
+			}
+		}
+		total += bits
+		i += length
+	}
+	return total
+}
+
+// Returns the number of characters in the classes used by a password
+func poolSize(runes []rune) int {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < 0x80:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	size := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			size += class.size
+		}
+	}
+	if size == 0 {
+		size = 1
+	}
+	return size
+}
+
+// Finds the longest common password of at least 4 characters starting at i.
+// Upper case letters add a bit for the variations an attacker would try.
+func matchCommon(runes, lower []rune, i int, _ float64) (int, float64) {
+	for l := min(len(lower)-i, longestCommon); l >= 4; l-- {
+		rank, ok := common[string(lower[i:i+l])]
+		if !ok {
+			continue
+		}
+
+		bits := math.Log2(float64(rank + 2))
+		for _, r := range runes[i : i+l] {
+			if unicode.IsUpper(r) {
+				bits++
+				break
+			}
+		}
+		return l, bits
+	}
+	return 0, 0
+}
+
+// Finds a run of at least 3 of the same character starting at i
+func matchRepeat(runes, _ []rune, i int, perChar float64) (int, float64) {
+	j := i + 1
+	for j < len(runes) && runes[j] == runes[i] {
+		j++
+	}
+	if l := j - i; l >= 3 {
+		return l, perChar + math.Log2(float64(l))
+	}
+	return 0, 0
+}
+
+// Finds a sequence of at least 3 characters with a step of 1 or -1 starting
+// at i. Sequences starting at an obvious character such as "a" or "1" are
+// cheaper to guess.
+func matchSequence(_, lower []rune, i int, perChar float64) (int, float64) {
+	if i+2 >= len(lower) {
+		return 0, 0
+	}
+
+	step := lower[i+1] - lower[i]
+	if step != 1 && step != -1 {
+		return 0, 0
+	}
+
+	j := i + 1
+	for j < len(lower) && lower[j]-lower[j-1] == step {
+		j++
+	}
+	l := j - i
+	if l < 3 {
+		return 0, 0
+	}
+
+	start := perChar
+	if strings.ContainsRune("aAzZ019", lower[i]) {
+		start = 1
+	}
+	return l, start + math.Log2(float64(l))
+}
+
+// Finds a walk of at least 4 keys along a keyboard row, forwards or
+// backwards, starting at i
+func matchKeyboard(_, lower []rune, i int, _ float64) (int, float64) {
+	longest := 0
+	for _, row := range keyboardRows {
+		for _, r := range []string{row, reverse(row)} {
+			// Walks can start anywhere on the row
+			k := strings.IndexRune(r, lower[i])
+			if k < 0 {
+				continue
+			}
+
+			l := 0
+			for i+l < len(lower) && k+l < len(r) && rune(r[k+l]) == lower[i+l] {
+				l++
+			}
+			if l > longest {
+				longest = l
+			}
+		}
+	}
+
+	if longest < 4 {
+		return 0, 0
+	}
+	// Choose a row, a direction and a starting key
+	return longest, math.Log2(float64(len(keyboardRows)*2*10)) + math.Log2(float64(longest))
+}
+
+func reverse(str string) string {
+	runes := []rune(str)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
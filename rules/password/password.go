@@ -0,0 +1,228 @@
+// Package password validates passwords against named policies, which set
+// requirements such as the minimum length, the character classes needed and
+// the minimum estimated entropy.
+package password
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Password", Password)
+}
+
+// A set of requirements for passwords. Zero values disable a requirement.
+type Policy struct {
+	// The minimum and maximum number of characters
+	MinLength, MaxLength int
+
+	// Require at least one upper case letter, lower case letter, digit or
+	// symbol (any punctuation or symbol character)
+	Upper, Lower, Digit, Symbol bool
+
+	// The maximum number of times a character may be repeated in a row
+	MaxRepeat int
+
+	// The minimum entropy in bits, as estimated by Entropy
+	MinEntropy float64
+
+	// Reject passwords in the list of common passwords
+	NoCommon bool
+}
+
+// Returned within an ErrMultiple for each requirement a password doesn't
+// meet. The embedded ValidationData has its Value and Struct cleared, so that
+// the password isn't kept in errors which may be logged.
+type ErrRequirement struct {
+	rules.ErrInvalid
+
+	// The requirement which wasn't met. This is the name of the Policy field,
+	// such as "MinLength" or "Symbol", or "Fields" if the password contains
+	// the value of another field.
+	Requirement string
+}
+
+var (
+	policies = map[string]Policy{
+		// Follows NIST SP 800-63B: a length requirement and a blocklist
+		// rather than composition rules
+		"default": {MinLength: 8, MaxLength: 64, NoCommon: true},
+		"strong":  {MinLength: 12, MaxLength: 128, Upper: true, Lower: true, Digit: true, Symbol: true, MaxRepeat: 3, MinEntropy: 50, NoCommon: true},
+	}
+	mu sync.RWMutex
+)
+
+// Registers a named policy which can be used in Password tags, so that every
+// service applies the same requirements:
+//
+//	password.RegisterPolicy("admin", password.Policy{MinLength: 16, MinEntropy: 70, NoCommon: true})
+//
+//	type Admin struct {
+//		Password string `validate:"Password:admin"`
+//	}
+//
+// If a policy with the same name already exists this will return an error.
+func RegisterPolicy(name string, policy Policy) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := policies[name]; ok {
+		return fmt.Errorf("Password policy '%s' already exists", name)
+	}
+
+	policies[name] = policy
+	return nil
+}
+
+// Passes if the data is a password meeting the requirements of a policy. The
+// "default" policy is used unless another is named, eg. 'Password:strong'.
+//
+// The password can also be checked against other fields such as the user's
+// name or email address, eg. 'Password:strong:fields=Username|Email'. It may
+// not equal or contain their values, or the local part of an email address.
+//
+// Invalid passwords return an ErrMultiple holding an ErrRequirement for every
+// requirement which isn't met, even if there's only one, so that Run reports
+// each as a separate failure.
+func Password(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	name := "default"
+	var fields []string
+	for _, arg := range data.SplitArgs() {
+		if strings.HasPrefix(arg, "fields=") {
+			fields = strings.Split(strings.TrimPrefix(arg, "fields="), "|")
+			continue
+		}
+		name = arg
+	}
+
+	mu.RLock()
+	policy, ok := policies[name]
+	mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("Unknown password policy '%s'", name)
+	}
+
+	// Errors are often logged, so they don't keep the password or the
+	// struct holding it
+	redacted := data
+	redacted.Value = nil
+	redacted.Struct = reflect.Value{}
+
+	var errs rules.ErrMultiple
+	fail := func(requirement, failure string, args ...interface{}) {
+		errs = append(errs, ErrRequirement{
+			ErrInvalid: rules.ErrInvalid{
+				ValidationData: redacted,
+				Failure:        fmt.Sprintf(failure, args...),
+			},
+			Requirement: requirement,
+		})
+	}
+
+	length := utf8.RuneCountInString(v)
+	if policy.MinLength > 0 && length < policy.MinLength {
+		fail("MinLength", "must be at least %d characters", policy.MinLength)
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		fail("MaxLength", "must be at most %d characters", policy.MaxLength)
+	}
+
+	if policy.Upper && strings.IndexFunc(v, unicode.IsUpper) < 0 {
+		fail("Upper", "must contain an upper case letter")
+	}
+	if policy.Lower && strings.IndexFunc(v, unicode.IsLower) < 0 {
+		fail("Lower", "must contain a lower case letter")
+	}
+	if policy.Digit && strings.IndexFunc(v, unicode.IsDigit) < 0 {
+		fail("Digit", "must contain a number")
+	}
+	if policy.Symbol && strings.IndexFunc(v, isSymbol) < 0 {
+		fail("Symbol", "must contain a symbol")
+	}
+
+	if policy.MaxRepeat > 0 && maxRepeat(v) > policy.MaxRepeat {
+		fail("MaxRepeat", "must not repeat a character more than %d times in a row", policy.MaxRepeat)
+	}
+
+	if policy.NoCommon && IsCommon(v) {
+		fail("NoCommon", "is a commonly used password")
+	} else if policy.MinEntropy > 0 && Entropy(v) < policy.MinEntropy {
+		fail("MinEntropy", "is too easy to guess")
+	}
+
+	for _, field := range fields {
+		sibling, err := data.Sibling(field)
+		if err != nil {
+			return err
+		}
+		if containsUserValue(v, sibling) {
+			fail("Fields", "must not contain the %s", field)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func isSymbol(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// Returns the length of the longest run of a repeated character
+func maxRepeat(str string) int {
+	longest, run := 0, 0
+	var prev rune
+	for i, r := range str {
+		if i > 0 && r == prev {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		prev = r
+	}
+	return longest
+}
+
+// Reports whether a password equals or contains the value of another field,
+// ignoring case. Email addresses are also checked by their local part. Values
+// shorter than three characters are ignored.
+func containsUserValue(password string, value interface{}) bool {
+	str, err := helper.ToString(value)
+	if err != nil {
+		return false
+	}
+
+	password = strings.ToLower(password)
+	values := []string{strings.ToLower(str)}
+	if i := strings.LastIndex(values[0], "@"); i > 0 {
+		values = append(values, values[0][:i])
+	}
+
+	for _, v := range values {
+		if utf8.RuneCountInString(v) >= 3 && strings.Contains(password, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package password
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestPassword(t *testing.T) {
+	var tests = []struct {
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{nil, []interface{}{"correct horse battery", "zq8#mW2!", []byte("long enough passphrase")}, []interface{}{"short", "password", "Password", "qwerty123", "", 12345678}},
+		{[]string{"strong"}, []interface{}{"Tr0ub4dor&3x!", "v9#Lq2$mZ7@w"}, []interface{}{"Password123!", "Qwerty123456!", "Aaaa1111!!!!x", "alllowercase1!", "ALLUPPERCASE1!", "NoDigitsHere!!", "NoSymbols12345", "Short1!"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := Password(object); err != nil {
+				t.Errorf("Unexpected error with valid Password%v value %q: %s", test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := Password(object); err == nil {
+				t.Errorf("Expected invalid Password%v value %q to fail validation", test.Args, v)
+			}
+		}
+	}
+
+	if err := Password(rules.ValidationData{Field: "Test", Value: "password", Args: []string{"unknown"}}); err == nil {
+		t.Errorf("Expected an unknown policy to return an error")
+	}
+}
+
+func TestPasswordRequirements(t *testing.T) {
+	var tests = []struct {
+		Password string
+		Expected []string
+	}{
+		{"Tr0ub4dor&3x!", nil},
+		{"short", []string{"MinLength", "Upper", "Digit", "Symbol", "MinEntropy"}},
+		{"aaaaaaaaaaaaaaaa", []string{"Upper", "Digit", "Symbol", "MaxRepeat", "MinEntropy"}},
+		{"Password123!", []string{"MinEntropy"}},
+		{"password", []string{"MinLength", "Upper", "Digit", "Symbol", "NoCommon"}},
+	}
+
+	for _, test := range tests {
+		err := Password(rules.ValidationData{Field: "Test", Value: test.Password, Args: []string{"strong"}})
+
+		// Failures are always an ErrMultiple, even for a single requirement
+		errs, ok := err.(rules.ErrMultiple)
+		if err != nil && !ok {
+			t.Errorf("Expected %q to return an ErrMultiple, got %T", test.Password, err)
+		}
+
+		var requirements []string
+		for _, e := range errs {
+			requirements = append(requirements, e.(ErrRequirement).Requirement)
+		}
+		if !reflect.DeepEqual(requirements, test.Expected) {
+			t.Errorf("Expected %q to fail %v, got %v", test.Password, test.Expected, requirements)
+		}
+	}
+}
+
+func TestPasswordFields(t *testing.T) {
+	type Signup struct {
+		Username string
+		Email    string
+		Password string
+	}
+
+	var tests = []struct {
+		Signup Signup
+		Valid  bool
+	}{
+		{Signup{"alice", "alice.smith@example.com", "purple monkey dishwasher"}, true},
+		{Signup{"alice", "alice.smith@example.com", "alice's secret key"}, false},
+		{Signup{"alice", "alice.smith@example.com", "ALICE.SMITH rocks 99"}, false},
+		{Signup{"al", "al@example.com", "al's purple dishwasher"}, true},
+		{Signup{"", "", "purple monkey dishwasher"}, true},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field:  "Password",
+			Value:  test.Signup.Password,
+			Args:   []string{"default:fields=Username|Email"},
+			Struct: reflect.ValueOf(test.Signup),
+		}
+
+		if err := Password(object); (err == nil) != test.Valid {
+			t.Errorf("Unexpected result validating %v: %v", test.Signup, err)
+		}
+	}
+
+	object := rules.ValidationData{
+		Field:  "Password",
+		Value:  "purple monkey dishwasher",
+		Args:   []string{"fields=Missing"},
+		Struct: reflect.ValueOf(Signup{}),
+	}
+	if err := Password(object); err == nil {
+		t.Errorf("Expected a missing field to return an error")
+	}
+}
+
+func TestRegisterPolicy(t *testing.T) {
+	if err := RegisterPolicy("test", Policy{MinLength: 4, Digit: true}); err != nil {
+		t.Fatalf("Unexpected error registering a policy: %s", err)
+	}
+	if err := RegisterPolicy("test", Policy{}); err == nil {
+		t.Errorf("Expected registering a policy twice to return an error")
+	}
+
+	object := rules.ValidationData{Field: "Test", Args: []string{"test"}}
+	object.Value = "abc1"
+	if err := Password(object); err != nil {
+		t.Errorf("Unexpected error with a valid password: %s", err)
+	}
+	object.Value = "abcd"
+	if err := Password(object); err == nil {
+		t.Errorf("Expected a password without a digit to fail")
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	var tests = []struct {
+		Password string
+		Min, Max float64
+	}{
+		{"", 0, 0},
+		{"password", 0, 4},
+		{"aaaaaaaaaa", 0, 10},
+		{"abcdefgh", 0, 5},
+		{"qwerty", 0, 5},
+		{"qwertyuiop", 0, 12},
+		{"zxcvbnm", 0, 10},
+		{"Tr0ub4dor&3", 60, 80},
+		{"correcthorsebatterystaple", 100, 130},
+	}
+
+	for _, test := range tests {
+		if bits := Entropy(test.Password); bits < test.Min || bits > test.Max {
+			t.Errorf("Expected the entropy of %q to be between %g and %g, got %g", test.Password, test.Min, test.Max, bits)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Package path contains rules for file paths and file content: path syntax,
+// traversal, extensions, MIME types and whether paths exist.
+//
+// Paths are checked the same way on every platform: both "/" and "\" are
+// treated as separators, and paths starting with a separator or a drive
+// letter such as "C:" are absolute.
+package path
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("FilePath", FilePath)
+	rules.Add("RelativePath", RelativePath)
+	rules.Add("NoTraversal", NoTraversal)
+	rules.Add("Extension", Extension)
+	rules.Add("MIME", MIME)
+	rules.Add("FileExists", FileExists)
+	rules.Add("DirExists", DirExists)
+}
+
+var rxDrive = regexp.MustCompile(`^[A-Za-z]:`)
+
+// Reports whether a path is absolute on any platform
+func isAbs(path string) bool {
+	return strings.HasPrefix(path, "/") || strings.HasPrefix(path, `\`) || rxDrive.MatchString(path)
+}
+
+// Returns the elements of a path, split on either separator
+func elements(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '\\' })
+}
+
+// Checks the data is a non-empty path of valid UTF-8 without control
+// characters, returning it as a string
+func toPath(data rules.ValidationData) (string, error) {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return "", rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if v == "" || !utf8.ValidString(v) || strings.IndexFunc(v, unicode.IsControl) >= 0 {
+		return "", rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid file path",
+		}
+	}
+
+	return v, nil
+}
+
+// Passes if the data is a non-empty file path of valid UTF-8 without control
+// characters such as NUL. 'FilePath:abs' also requires an absolute path.
+func FilePath(data rules.ValidationData) error {
+	v, err := toPath(data)
+	if err != nil {
+		return err
+	}
+
+	if len(data.Args) > 0 {
+		if data.Args[0] != "abs" {
+			return fmt.Errorf("Unknown FilePath argument '%s': expected abs", data.Args[0])
+		}
+		if !isAbs(v) {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "must be an absolute path",
+			}
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a valid file path, as with FilePath, which is
+// relative: it doesn't start with "/", "\" or a drive letter.
+func RelativePath(data rules.ValidationData) error {
+	v, err := toPath(data)
+	if err != nil {
+		return err
+	}
+
+	if isAbs(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "must be a relative path",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a path without any ".." elements, so that it can't
+// refer to a parent directory. Combine this with RelativePath to keep paths
+// within a directory.
+func NoTraversal(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	for _, element := range elements(v) {
+		if element == ".." {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "must not contain '..'",
+			}
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a path with one of the extensions given, compared
+// case insensitively, eg. 'Extension:jpg|jpeg|png'. Extensions may contain
+// dots, such as 'Extension:tar.gz'.
+func Extension(data rules.ValidationData) error {
+	// We should always be provided with extensions to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'Extension:jpg|png')")
+	}
+
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	// Only the last element of the path has an extension, and paths ending
+	// in a separator are directories
+	name := ""
+	if elems := elements(v); len(elems) > 0 && !strings.HasSuffix(v, "/") && !strings.HasSuffix(v, `\`) {
+		name = strings.ToLower(elems[len(elems)-1])
+	}
+
+	extensions := strings.Split(data.Args[0], "|")
+	for _, ext := range extensions {
+		ext = "." + strings.ToLower(strings.TrimPrefix(ext, "."))
+		if len(name) > len(ext) && strings.HasSuffix(name, ext) {
+			return nil
+		}
+	}
+
+	return rules.ErrInvalid{
+		ValidationData: data,
+		Failure:        fmt.Sprintf("must have the extension %s", strings.Join(extensions, ", ")),
+	}
+}
+
+// Passes if the data is content whose MIME type, as detected by
+// http.DetectContentType, is one of those given, eg.
+// 'MIME:image/png|image/jpeg'. A type ending in "/*" matches any subtype, eg.
+// 'MIME:image/*'. Parameters such as "; charset=utf-8" are ignored.
+//
+// The data is usually a []byte holding a file's content, though strings are
+// also accepted.
+func MIME(data rules.ValidationData) error {
+	// We should always be provided with MIME types to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'MIME:image/png')")
+	}
+
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a byte slice or string",
+		}
+	}
+
+	detected := http.DetectContentType([]byte(v))
+	if i := strings.IndexByte(detected, ';'); i >= 0 {
+		detected = detected[:i]
+	}
+
+	allowed := strings.Split(data.Args[0], "|")
+	for _, t := range allowed {
+		if t == detected || (strings.HasSuffix(t, "/*") && strings.HasPrefix(detected, strings.TrimSuffix(t, "*"))) {
+			return nil
+		}
+	}
+
+	return rules.ErrInvalid{
+		ValidationData: data,
+		Failure:        fmt.Sprintf("has the MIME type %s, expected %s", detected, strings.Join(allowed, " or ")),
+	}
+}
+
+// Passes if the data is the path of a regular file which exists. This uses
+// the validator's FS option, or the local filesystem if it isn't set.
+func FileExists(data rules.ValidationData) error {
+	return checkExists(data, false)
+}
+
+// Passes if the data is the path of a directory which exists. This uses the
+// validator's FS option, or the local filesystem if it isn't set.
+func DirExists(data rules.ValidationData) error {
+	return checkExists(data, true)
+}
+
+func checkExists(data rules.ValidationData, dir bool) error {
+	v, err := toPath(data)
+	if err != nil {
+		return err
+	}
+
+	kind := "file"
+	if dir {
+		kind = "directory"
+	}
+
+	info, err := data.Options.Stat(v)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must be an existing %s", kind),
+		}
+	}
+
+	if dir != info.IsDir() || (!dir && !info.Mode().IsRegular()) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a %s", kind),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package path
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+var png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestPath(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{FilePath, "FilePath", nil, []interface{}{"a.txt", "/etc/hosts", `C:\Windows`, "dir/../file", "café.txt"}, []interface{}{"", "nul\x00.txt", "new\nline", "\xff.txt", 1}},
+		{FilePath, "FilePath", []string{"abs"}, []interface{}{"/etc/hosts", `C:\Windows`, `\\server\share`}, []interface{}{"etc/hosts", "./a"}},
+		{RelativePath, "RelativePath", nil, []interface{}{"a.txt", "dir/file", "./file", "../file"}, []interface{}{"/etc/hosts", `\file`, `C:\Windows`, "c:file", ""}},
+		{NoTraversal, "NoTraversal", nil, []interface{}{"a/b/c", "a..b", "..a/b", "/etc/hosts", ""}, []interface{}{"..", "../a", "a/../b", `a\..\b`, "a/.."}},
+		{Extension, "Extension", []string{"jpg|.png"}, []interface{}{"photo.jpg", "dir/photo.JPG", "photo.png", `dir\a.png`}, []interface{}{"photo.gif", "jpg", ".jpg", "photo.jpg/", "photojpg", "photo.jpg.exe"}},
+		{Extension, "Extension", []string{"tar.gz"}, []interface{}{"archive.tar.gz"}, []interface{}{"archive.gz"}},
+		{MIME, "MIME", []string{"image/png|image/jpeg"}, []interface{}{png, string(png)}, []interface{}{[]byte("hello"), []byte("%PDF-1.4"), []byte{}}},
+		{MIME, "MIME", []string{"image/*"}, []interface{}{png}, []interface{}{[]byte("<html>")}},
+		{MIME, "MIME", []string{"text/plain"}, []interface{}{[]byte("hello")}, []interface{}{png}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %q: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %q to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
+
+func TestExists(t *testing.T) {
+	options := rules.Options{FS: fstest.MapFS{
+		"etc/app/config.yml": {Data: []byte("key: value")},
+		"var/data":           {Mode: os.ModeDir},
+	}}
+
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Valid   []string
+		Invalid []string
+	}{
+		{FileExists, "FileExists", []string{"etc/app/config.yml", "/etc/app/config.yml", "etc/../etc/app/config.yml"}, []string{"etc/app", "etc/app/missing.yml", "var/data", ""}},
+		{DirExists, "DirExists", []string{"etc", "/etc/app", "var/data", "/"}, []string{"etc/app/config.yml", "missing"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field:   "Test",
+			Options: options,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s value %q: %s", test.Name, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s value %q to fail validation", test.Name, v)
+			}
+		}
+	}
+}
+
+func TestExistsLocal(t *testing.T) {
+	dir := t.TempDir()
+	file, err := os.CreateTemp(dir, "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	if err := FileExists(rules.ValidationData{Field: "Test", Value: file.Name()}); err != nil {
+		t.Errorf("Unexpected error with an existing file: %s", err)
+	}
+	if err := DirExists(rules.ValidationData{Field: "Test", Value: dir}); err != nil {
+		t.Errorf("Unexpected error with an existing directory: %s", err)
+	}
+	if err := FileExists(rules.ValidationData{Field: "Test", Value: dir}); err == nil {
+		t.Errorf("Expected a directory to fail FileExists")
+	}
+}
@@ -0,0 +1,123 @@
+package phone
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+//go:embed numbering_plans.txt
+var embeddedPlans string
+
+// The numbering plan of a region
+type plan struct {
+	region      string
+	callingCode string
+	trunk       string
+	number      *regexp.Regexp
+	mobile      *regexp.Regexp
+}
+
+var (
+	// Plans keyed by region, and by calling code for numbers given in E.164
+	// form. Several regions can share a calling code, such as US and CA.
+	regions      map[string]*plan
+	callingCodes map[string][]*plan
+	mu           sync.RWMutex
+)
+
+func init() {
+	if err := LoadNumberingPlans(strings.NewReader(embeddedPlans)); err != nil {
+		panic(err)
+	}
+}
+
+// Replaces the embedded numbering plans, which are a simplified subset for
+// common regions, with plans read from r. Each line holds a region, its
+// calling code, its trunk prefix ("-" for none), a pattern matching its
+// national significant numbers and a pattern matching its mobile numbers:
+//
+//	GB  44  0  1\d{8,9}|[2-9]\d{9}  7[1-57-9]\d{8}
+//
+// Regions sharing a calling code are told apart by their number patterns: a
+// number belongs to the first region listed whose pattern it matches. Blank
+// lines and lines starting with "#" are ignored.
+func LoadNumberingPlans(r io.Reader) error {
+	byRegion := map[string]*plan{}
+	byCode := map[string][]*plan{}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 5 {
+			return fmt.Errorf("Invalid numbering plan on line %d: expected 5 fields", n)
+		}
+
+		p := &plan{region: fields[0], callingCode: fields[1], trunk: fields[2]}
+		if p.trunk == "-" {
+			p.trunk = ""
+		}
+
+		var err error
+		if p.number, err = regexp.Compile(`^(?:` + fields[3] + `)$`); err != nil {
+			return fmt.Errorf("Invalid number pattern on line %d: %s", n, err)
+		}
+		if p.mobile, err = regexp.Compile(`^(?:` + fields[4] + `)$`); err != nil {
+			return fmt.Errorf("Invalid mobile pattern on line %d: %s", n, err)
+		}
+
+		byRegion[p.region] = p
+		byCode[p.callingCode] = append(byCode[p.callingCode], p)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	mu.Lock()
+	regions, callingCodes = byRegion, byCode
+	mu.Unlock()
+	return nil
+}
+
+// Returns the plans for the calling code at the start of an E.164 number's
+// digits, and the calling code itself. Calling codes are prefix-free so at
+// most one can match.
+func plansFor(digits string) ([]*plan, string) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for i := 1; i <= 3 && i <= len(digits); i++ {
+		if plans, ok := callingCodes[digits[:i]]; ok {
+			return plans, digits[:i]
+		}
+	}
+	return nil, ""
+}
+
+// Returns the plan a national significant number belongs to: the first plan
+// for its calling code whose pattern it matches, or nil if there's none.
+func resolve(plans []*plan, national string) *plan {
+	for _, p := range plans {
+		if p.number.MatchString(national) {
+			return p
+		}
+	}
+	return nil
+}
+
+func planFor(region string) (*plan, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	p, ok := regions[region]
+	return p, ok
+}
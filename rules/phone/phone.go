@@ -0,0 +1,171 @@
+// Package phone validates telephone numbers in E.164 form, or in national or
+// international form for a given region, against embedded numbering plans.
+package phone
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Phone", Phone)
+}
+
+var (
+	rxE164 = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+	// Separators people use when writing numbers, which are ignored when
+	// parsing a number for a region
+	separators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+)
+
+// A parsed phone number
+type Number struct {
+	// The number in E.164 form, eg. "+442079460018"
+	E164 string
+	// The region the number belongs to, or "" if its calling code has no
+	// numbering plan
+	Region string
+	// Whether the number is a mobile number. This is always false if Region
+	// is ""
+	Mobile bool
+}
+
+// Parses a phone number, returning false if it isn't valid.
+//
+// If region is "" the number must be in E.164 form, eg. "+442079460018". If
+// its calling code has a numbering plan the number must also be valid for one
+// of the regions using that calling code. Numbers with any other calling code
+// are only checked against E.164, and have an empty Region.
+//
+// Otherwise number can be in the national form used in the region, such as
+// "020 7946 0018" for GB, or in international form, such as
+// "+44 20 7946 0018". Spaces, hyphens, dots and brackets are ignored. Use the
+// E164 field of the result to store the number in a normalized form.
+func Parse(number, region string) (Number, bool) {
+	if region == "" {
+		return parseE164(number)
+	}
+
+	p, ok := planFor(region)
+	if !ok {
+		return Number{}, false
+	}
+
+	digits := separators.Replace(number)
+	if strings.HasPrefix(digits, "+") {
+		if !rxE164.MatchString(digits) || !strings.HasPrefix(digits[1:], p.callingCode) {
+			return Number{}, false
+		}
+		digits = digits[1+len(p.callingCode):]
+	} else {
+		if digits == "" || strings.Trim(digits, "0123456789") != "" {
+			return Number{}, false
+		}
+		if p.trunk != "" && strings.HasPrefix(digits, p.trunk) && !p.number.MatchString(digits) {
+			digits = digits[len(p.trunk):]
+		}
+	}
+
+	if !p.number.MatchString(digits) || len(p.callingCode)+len(digits) > 15 {
+		return Number{}, false
+	}
+
+	// A number matching an earlier region with the same calling code, such
+	// as a Canadian number checked against the general US plan, belongs to
+	// that region instead
+	if plans, _ := plansFor(p.callingCode); resolve(plans, digits) != p {
+		return Number{}, false
+	}
+
+	return Number{
+		E164:   "+" + p.callingCode + digits,
+		Region: p.region,
+		Mobile: p.mobile.MatchString(digits),
+	}, true
+}
+
+func parseE164(number string) (Number, bool) {
+	if !rxE164.MatchString(number) {
+		return Number{}, false
+	}
+
+	plans, code := plansFor(number[1:])
+	if len(plans) == 0 {
+		return Number{E164: number}, true
+	}
+
+	national := number[1+len(code):]
+	p := resolve(plans, national)
+	if p == nil {
+		return Number{}, false
+	}
+
+	return Number{
+		E164:   number,
+		Region: p.region,
+		Mobile: p.mobile.MatchString(national),
+	}, true
+}
+
+// Passes if the data is a phone number in E.164 form, such as "+442079460018".
+// Numbers with a calling code listed in the embedded numbering plans must also
+// be valid for that region; numbers with other calling codes are only checked
+// against E.164.
+//
+// The following arguments can be combined, eg. 'Phone:GB:mobile':
+//
+//	GB      a region code: the number must be valid for this region and may
+//	        be written in national form, eg. "020 7946 0018". Only regions
+//	        with a numbering plan are supported, and any other region is a
+//	        configuration error
+//	mobile  the number must be a mobile number
+//
+// Use Parse to get the normalized E.164 form of a valid number.
+func Phone(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	var region string
+	var mobile bool
+	for _, arg := range data.SplitArgs() {
+		if arg == "mobile" {
+			mobile = true
+			continue
+		}
+		if _, ok := planFor(arg); !ok {
+			return fmt.Errorf("Unknown region '%s' in Phone rule", arg)
+		}
+		region = arg
+	}
+
+	number, ok := Parse(v, region)
+	if !ok {
+		failure := "is not a valid E.164 phone number"
+		if region != "" {
+			failure = fmt.Sprintf("is not a valid %s phone number", region)
+		}
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        failure,
+		}
+	}
+
+	if mobile && !number.Mobile {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a mobile phone number",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package phone
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestPhone(t *testing.T) {
+	var tests = []struct {
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{nil, []interface{}{"+442079460018", "+14155552671", "+61412345678", "+999123456789"}, []interface{}{"442079460018", "+44 20 7946 0018", "+0442079460018", "+4420794600181234", "+440079460018", "+1415555267", ""}},
+		{[]string{"GB"}, []interface{}{"020 7946 0018", "07700 900123", "+44 20 7946 0018", "(020) 7946-0018", "+447700900123"}, []interface{}{"+1 415 555 2671", "020 7946 001", "00 7946 0018", "020 7946 0018x", "+44 (0) 20 7946 0018x"}},
+		{[]string{"US"}, []interface{}{"(415) 555-2671", "1 415 555 2671", "+1 415.555.2671"}, []interface{}{"(015) 555-2671", "415 155 2671", "+44 20 7946 0018"}},
+		{[]string{"mobile"}, []interface{}{"+447700900123", "+61412345678"}, []interface{}{"+442079460018", "+999123456789"}},
+		{[]string{"GB:mobile"}, []interface{}{"07700 900123"}, []interface{}{"020 7946 0018"}},
+		{[]string{"RU"}, []interface{}{"8 912 345 67 89", "+7 912 345 67 89"}, []interface{}{"8 112 345 67 89"}},
+		{[]string{"KZ"}, []interface{}{"8 701 234 5678", "+7 727 123 4567"}, []interface{}{"+7 912 345 67 89", "8 112 345 67 89"}},
+		{[]string{"KZ:mobile"}, []interface{}{"+7 701 234 5678"}, []interface{}{"+7 727 123 4567"}},
+		{[]string{"CA"}, []interface{}{"(416) 555-0123", "+1 604 555 0123"}, []interface{}{"(415) 555-2671", "+1 212 555 0123"}},
+		{[]string{"US"}, []interface{}{"+1 212 555 0123"}, []interface{}{"(416) 555-0123", "+1 787 555 0123"}},
+		{[]string{"PR"}, []interface{}{"787 555 0123"}, []interface{}{"212 555 0123"}},
+		{[]string{"PT"}, []interface{}{"912 345 678", "+351 212 345 678"}, []interface{}{"012 345 678"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := Phone(object); err != nil {
+				t.Errorf("Unexpected error with valid Phone%v value %v: %s", test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := Phone(object); err == nil {
+				t.Errorf("Expected invalid Phone%v value %v to fail validation", test.Args, v)
+			}
+		}
+	}
+
+	if err := Phone(rules.ValidationData{Field: "Test", Value: "+442079460018", Args: []string{"XX"}}); err == nil {
+		t.Errorf("Expected an unknown region to return an error")
+	}
+}
+
+func TestParse(t *testing.T) {
+	var tests = []struct {
+		Number, Region string
+		Expected       Number
+	}{
+		{"020 7946 0018", "GB", Number{"+442079460018", "GB", false}},
+		{"07700 900123", "GB", Number{"+447700900123", "GB", true}},
+		{"+14155552671", "", Number{"+14155552671", "US", true}},
+		{"+14165550123", "", Number{"+14165550123", "CA", true}},
+		{"+77012345678", "", Number{"+77012345678", "KZ", true}},
+		{"+79123456789", "", Number{"+79123456789", "RU", true}},
+		{"612 345 678", "ES", Number{"+34612345678", "ES", true}},
+		{"+999123456789", "", Number{"+999123456789", "", false}},
+	}
+
+	for _, test := range tests {
+		number, ok := Parse(test.Number, test.Region)
+		if !ok || number != test.Expected {
+			t.Errorf("Expected %q in %q to parse as %v, got %v (%t)", test.Number, test.Region, test.Expected, number, ok)
+		}
+	}
+}
+
+func TestLoadNumberingPlans(t *testing.T) {
+	defer LoadNumberingPlans(strings.NewReader(embeddedPlans))
+
+	if err := LoadNumberingPlans(strings.NewReader("GB 44 0 [")); err == nil {
+		t.Errorf("Expected an invalid plan to return an error")
+	}
+
+	if err := LoadNumberingPlans(strings.NewReader("# Test\nXX 999 - 1\\d{5} 1\\d{5}\n")); err != nil {
+		t.Fatalf("Unexpected error loading numbering plans: %s", err)
+	}
+	if _, ok := Parse("+999123456", ""); !ok {
+		t.Errorf("Expected a number in a loaded plan to parse")
+	}
+	if _, ok := Parse("020 7946 0018", "GB"); ok {
+		t.Errorf("Expected loading plans to replace the embedded plans")
+	}
+}
@@ -0,0 +1,153 @@
+// Package postalcode validates postal codes using per-country formats.
+package postalcode
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("PostalCode", PostalCode)
+
+	scanner := bufio.NewScanner(strings.NewReader(embeddedFormats))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Patterns can contain spaces, so only split off the country
+		fields := strings.SplitN(line, " ", 2)
+		if err := AddFormat(fields[0], strings.TrimSpace(fields[1])); err != nil {
+			panic(err)
+		}
+	}
+}
+
+//go:embed formats.txt
+var embeddedFormats string
+
+var (
+	// Postal code formats keyed by country
+	formats = map[string]*regexp.Regexp{}
+	mu      sync.RWMutex
+
+	// Used for countries without a known format with the generic argument:
+	// up to 10 letters, digits, spaces and hyphens
+	rxGeneric = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 -]{1,9}$`)
+)
+
+// Adds or replaces the postal code format for a country, given as an ISO
+// 3166-1 alpha-2 code. The pattern must match the whole postal code and is
+// case insensitive:
+//
+//	postalcode.AddFormat("LV", `LV-\d{4}`)
+func AddFormat(country, pattern string) error {
+	rx, err := regexp.Compile(`^(?i:` + pattern + `)$`)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	formats[strings.ToUpper(country)] = rx
+	mu.Unlock()
+	return nil
+}
+
+func formatFor(country string) (*regexp.Regexp, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	rx, ok := formats[country]
+	return rx, ok
+}
+
+// Passes if the data is a postal code in the format used by a country. The
+// country is either given in the tag as an ISO 3166-1 alpha-2 code, eg.
+// 'PostalCode:CA', or read from another field, eg. 'PostalCode:field=Country':
+//
+//	type Address struct {
+//		Country    string `validate:"Country"`
+//		PostalCode string `validate:"PostalCode:field=Country"`
+//	}
+//
+// When the country comes from another field and is empty the postal code
+// isn't checked.
+//
+// Formats are embedded for about 90 countries, and AddFormat adds more. A
+// postal code for a country without a known format fails, unless it's empty
+// as many of these countries don't use postal codes. To accept any postal
+// code of up to 10 letters, digits, spaces and hyphens for these countries
+// instead, add the generic argument, eg. 'PostalCode:field=Country:generic'.
+func PostalCode(data rules.ValidationData) error {
+	// We should always be provided with a country to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'PostalCode:CA')")
+	}
+
+	args := data.SplitArgs()
+	generic := false
+	for _, arg := range args[1:] {
+		if arg != "generic" {
+			return fmt.Errorf("Unknown PostalCode argument '%s': expected generic", arg)
+		}
+		generic = true
+	}
+
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	country := args[0]
+	name, fromField := rules.FieldArg(country)
+	if fromField {
+		sibling, err := data.Sibling(name)
+		if err != nil {
+			return err
+		}
+		if country, err = helper.ToString(sibling); err != nil {
+			return fmt.Errorf("Field '%s' can't take its country from '%s': it is not a string", data.Field, name)
+		}
+		if country == "" {
+			return nil
+		}
+	}
+
+	// Country codes are matched case insensitively, as in rules/iso
+	country = strings.ToUpper(country)
+	rx, ok := formatFor(country)
+	switch {
+	case ok:
+	case !fromField:
+		return fmt.Errorf("No postal code format is known for '%s'", country)
+	case generic:
+		rx = rxGeneric
+	case v == "":
+		return nil
+	default:
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("can't be checked: no postal code format is known for %s", country),
+		}
+	}
+
+	if !rx.MatchString(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a valid postal code for %s", country),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package postalcode
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestPostalCode(t *testing.T) {
+	var tests = []struct {
+		Country string
+		Valid   []string
+		Invalid []string
+	}{
+		{"US", []string{"90210", "90210-1234"}, []string{"9021", "90210-12", "ABCDE", ""}},
+		{"CA", []string{"K1A 0B1", "k1a0b1", "M5V 3L9"}, []string{"D1A 0B1", "K1A 0D1", "K1A-0B1", "90210"}},
+		{"GB", []string{"SW1A 1AA", "EC1A 1BB", "M1 1AE", "B33 8TH", "CR2 6XH", "DN55 1PT", "W1A 0AX", "GIR 0AA", "sw1a1aa"}, []string{"SW1A 1CA", "QW1 1AA", "SW1A", "12345"}},
+		{"NL", []string{"1234 AB", "1234AB"}, []string{"0123 AB", "1234"}},
+		{"IE", []string{"D02 X285", "D6W 1234"}, []string{"B02 X285", "D02"}},
+		{"JP", []string{"100-0001", "1000001"}, []string{"100-001"}},
+		{"PL", []string{"00-950"}, []string{"00950"}},
+		{"LV", []string{"LV-1050", "lv-1050"}, []string{"1050"}},
+		{"LI", []string{"9490"}, []string{"9500"}},
+		{"ca", []string{"K1A 0B1"}, []string{"90210"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  []string{test.Country},
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := PostalCode(object); err != nil {
+				t.Errorf("Unexpected error with valid %s postal code %q: %s", test.Country, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := PostalCode(object); err == nil {
+				t.Errorf("Expected invalid %s postal code %q to fail validation", test.Country, v)
+			}
+		}
+	}
+
+	if err := PostalCode(rules.ValidationData{Field: "Test", Value: "12345", Args: []string{"XX"}}); err == nil {
+		t.Errorf("Expected an unknown country in the tag to return an error")
+	}
+	if err := PostalCode(rules.ValidationData{Field: "Test", Value: "12345", Args: []string{"US:lenient"}}); err == nil {
+		t.Errorf("Expected an unknown argument to return an error")
+	}
+}
+
+func TestPostalCodeCountryField(t *testing.T) {
+	type Address struct {
+		PostalCode string
+		Country    string
+	}
+
+	var tests = []struct {
+		Address Address
+		Args    string
+		Valid   bool
+	}{
+		{Address{"K1A 0B1", "CA"}, "field=Country", true},
+		{Address{"90210", "CA"}, "field=Country", false},
+		{Address{"90210", "US"}, "field=Country", true},
+		{Address{"K1A 0B1", "US"}, "field=Country", false},
+		{Address{"SW1A 1AA", "gb"}, "field=Country", true},
+		{Address{"90210", "gb"}, "field=Country", false},
+		{Address{"anything", ""}, "field=Country", true},
+
+		// Countries without a known format only accept an empty postal code,
+		// unless the generic format is allowed
+		{Address{"", "HK"}, "field=Country", true},
+		{Address{"12345", "HK"}, "field=Country", false},
+		{Address{"12345", "HK"}, "field=Country:generic", true},
+		{Address{"!!", "HK"}, "field=Country:generic", false},
+		{Address{"90210-12", "US"}, "field=Country:generic", false},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field:  "PostalCode",
+			Value:  test.Address.PostalCode,
+			Args:   []string{test.Args},
+			Struct: reflect.ValueOf(test.Address),
+		}
+
+		if err := PostalCode(object); (err == nil) != test.Valid {
+			t.Errorf("Unexpected result validating %v with %s: %v", test.Address, test.Args, err)
+		}
+	}
+}
+
+func TestAddFormat(t *testing.T) {
+	defer func() {
+		mu.Lock()
+		delete(formats, "BM")
+		mu.Unlock()
+	}()
+
+	if err := AddFormat("BM", `[A-Z]{2} ?\d{2}`); err != nil {
+		t.Fatalf("Unexpected error adding a format: %s", err)
+	}
+
+	object := rules.ValidationData{Field: "Test", Value: "HM 12", Args: []string{"BM"}}
+	if err := PostalCode(object); err != nil {
+		t.Errorf("Unexpected error with a postal code in an added format: %s", err)
+	}
+
+	if err := AddFormat("BM", `[`); err == nil {
+		t.Errorf("Expected an invalid pattern to return an error")
+	}
+}
@@ -1,6 +1,10 @@
 package rules
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
 
 // This maps all validation tags to the corresponding validation methods
 var rules map[string]ValidatorFunc
@@ -24,11 +28,76 @@ type ValidationData struct {
 	// }
 	//
 	// Unfortunately, due to the nature of tags these will always be strings.
+	// Everything after the first colon is passed as one argument; rules taking
+	// more than one argument can use SplitArgs.
 	Args []string
+
+	// Options from the validator running this rule
+	Options Options
+
+	// The struct containing the field being validated. Rules which compare
+	// against another field use this via Sibling.
+	Struct reflect.Value
+}
+
+// Returns the value of another field in the struct being validated, for rules
+// which compare fields against each other:
+//
+//	struct {
+//		StartsAt time.Time
+//		EndsAt   time.Time `validate:"After:field=StartsAt"`
+//	}
+func (d ValidationData) Sibling(name string) (interface{}, error) {
+	if d.Struct.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("Field '%s' can't be compared with '%s' outside of a struct", d.Field, name)
+	}
+
+	field := d.Struct.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil, fmt.Errorf("Field '%s' can't be compared with '%s': no such exported field", d.Field, name)
+	}
+	return field.Interface(), nil
+}
+
+// Parses an argument of the form "field=Name", which rules use to refer to
+// another field in the struct. Returns false if the argument isn't of this
+// form.
+func FieldArg(arg string) (name string, ok bool) {
+	if strings.HasPrefix(arg, "field=") {
+		return arg[len("field="):], true
+	}
+	return "", false
+}
+
+// Splits the arguments on ":", so that `MaxLength:10:runes` gives
+// []string{"10", "runes"}. An argument starting with a quote runs to the
+// closing quote, as with quoted arguments in tags, so `OneOf:'12:00|13:00'`
+// gives []string{"12:00|13:00"}. An unterminated quote is left in place.
+func (d ValidationData) SplitArgs() []string {
+	var args []string
+	for _, arg := range d.Args {
+		for {
+			var quoted string
+			if strings.HasPrefix(arg, "'") {
+				if end := strings.Index(arg[1:], "'"); end >= 0 {
+					quoted, arg = arg[1:end+1], arg[end+2:]
+				}
+			}
+
+			i := strings.Index(arg, ":")
+			if i < 0 {
+				args = append(args, quoted+arg)
+				break
+			}
+			args = append(args, quoted+arg[:i])
+			arg = arg[i+1:]
+		}
+	}
+	return args
 }
 
-// All validation methods must return an ErrInvalid error type if the data
-// is invalid, or nil if the data is valid
+// All validation methods must return an ErrInvalid error type, or an
+// ErrMultiple of them, if the data is invalid, or nil if the data is valid
 type ValidatorFunc func(ValidationData) error
 
 // Add a new validation method for a given struct tag. If a validation method
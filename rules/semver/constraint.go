@@ -0,0 +1,280 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A version constraint: alternatives separated by "||", each of which is a
+// set of comparisons that must all be satisfied
+type Constraint struct {
+	sets [][]comparator
+	str  string
+}
+
+type comparator struct {
+	op string // One of =, !=, >, >=, < or <=
+	v  Version
+}
+
+// A version which may be missing its minor and patch numbers, such as "1.2"
+// or "1.x"
+type partial struct {
+	Version
+	parts int // The number of major, minor and patch numbers given
+}
+
+var operators = []string{">=", "<=", "!=", ">", "<", "=", "~", "^"}
+
+// Parses a version constraint. Constraints are comparisons separated by
+// spaces or commas, all of which must be satisfied, and alternatives are
+// separated by "||":
+//
+//	>=1.2.0 <2.0.0    at least 1.2.0 and below 2.0.0
+//	=1.2.3, !=1.2.4   equal to, or not equal to, a version
+//	1.2.x, 1.*, *     any version matching the numbers given
+//	~1.2.3            at least 1.2.3 and below 1.3.0
+//	^1.2.3            at least 1.2.3 and below 2.0.0 (below 0.3.0 for ^0.2.3)
+//	1.2 - 1.4         at least 1.2.0 and below 1.5.0
+//	^1.2 || ^2.0      either range
+//
+// A leading "v" on versions is ignored.
+func ParseConstraint(str string) (Constraint, error) {
+	c := Constraint{str: str}
+
+	for _, alt := range strings.Split(strings.Replace(str, ",", " ", -1), "||") {
+		set, err := parseSet(strings.Fields(alt))
+		if err != nil {
+			return Constraint{}, fmt.Errorf("Invalid version constraint '%s': %s", str, err)
+		}
+		c.sets = append(c.sets, set)
+	}
+	return c, nil
+}
+
+func parseSet(tokens []string) ([]comparator, error) {
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty constraint")
+	}
+
+	// Hyphen ranges, such as "1.2.3 - 2.3.4"
+	if len(tokens) == 3 && tokens[1] == "-" {
+		lower, err := parsePartial(tokens[0])
+		if err != nil {
+			return nil, err
+		}
+		upper, err := parsePartial(tokens[2])
+		if err != nil {
+			return nil, err
+		}
+
+		set, _ := desugar(">=", lower)
+		if upper.parts > 0 {
+			bound, _ := desugar("<=", upper)
+			set = append(set, bound...)
+		}
+		return set, nil
+	}
+
+	var set []comparator
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		op := ""
+		for _, o := range operators {
+			if strings.HasPrefix(token, o) {
+				op, token = o, token[len(o):]
+				break
+			}
+		}
+
+		// Allow a space between the operator and the version, as in ">= 1.2"
+		if token == "" && op != "" && i+1 < len(tokens) {
+			i++
+			token = tokens[i]
+		}
+
+		p, err := parsePartial(token)
+		if err != nil {
+			return nil, err
+		}
+
+		comparators, err := desugar(op, p)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, comparators...)
+	}
+	return set, nil
+}
+
+// Parses a version which may be partial or use wildcards, such as "1.2",
+// "1.x" or "*"
+func parsePartial(str string) (partial, error) {
+	str = strings.TrimPrefix(str, "v")
+
+	main, suffix := str, ""
+	if i := strings.IndexAny(str, "-+"); i >= 0 {
+		main, suffix = str[:i], str[i:]
+	}
+
+	var p partial
+	numbers := []*uint64{&p.Major, &p.Minor, &p.Patch}
+	wildcard := false
+	for i, part := range strings.Split(main, ".") {
+		if i >= len(numbers) {
+			return p, fmt.Errorf("'%s' has too many numbers", str)
+		}
+
+		if part == "x" || part == "X" || part == "*" {
+			wildcard = true
+			continue
+		}
+		if wildcard || part == "" || (len(part) > 1 && part[0] == '0') {
+			return p, fmt.Errorf("'%s' is not a valid version", str)
+		}
+
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return p, fmt.Errorf("'%s' is not a valid version", str)
+		}
+		*numbers[i] = n
+		p.parts++
+	}
+
+	if suffix != "" {
+		if p.parts != 3 {
+			return p, fmt.Errorf("'%s' can't have a prerelease or build without a full version", str)
+		}
+		v, err := Parse(main + suffix)
+		if err != nil {
+			return p, fmt.Errorf("'%s' is not a valid version", str)
+		}
+		p.Version = v
+	}
+	return p, nil
+}
+
+// Turns an operator and partial version into comparisons with full versions
+func desugar(op string, p partial) ([]comparator, error) {
+	// The version after the range a partial version covers, eg. 1.3.0 for 1.2
+	var next Version
+	switch p.parts {
+	case 1:
+		next = Version{Major: p.Major + 1}
+	case 2:
+		next = Version{Major: p.Major, Minor: p.Minor + 1}
+	}
+	lower := Version{Major: p.Major, Minor: p.Minor, Patch: p.Patch, Prerelease: p.Prerelease}
+	all := []comparator{{">=", Version{}}}
+
+	switch op {
+	case "", "=":
+		switch p.parts {
+		case 0:
+			return all, nil
+		case 3:
+			return []comparator{{"=", lower}}, nil
+		}
+		return []comparator{{">=", lower}, {"<", next}}, nil
+	case "!=":
+		if p.parts != 3 {
+			return nil, fmt.Errorf("'!=' needs a full version")
+		}
+		return []comparator{{"!=", lower}}, nil
+	case ">":
+		switch p.parts {
+		case 0:
+			return nil, fmt.Errorf("no version is greater than '*'")
+		case 3:
+			return []comparator{{">", lower}}, nil
+		}
+		return []comparator{{">=", next}}, nil
+	case ">=":
+		return []comparator{{">=", lower}}, nil
+	case "<":
+		if p.parts == 0 {
+			return nil, fmt.Errorf("no version is less than '*'")
+		}
+		return []comparator{{"<", lower}}, nil
+	case "<=":
+		switch p.parts {
+		case 0:
+			return all, nil
+		case 3:
+			return []comparator{{"<=", lower}}, nil
+		}
+		return []comparator{{"<", next}}, nil
+	case "~":
+		switch p.parts {
+		case 0:
+			return all, nil
+		case 1:
+			return []comparator{{">=", lower}, {"<", next}}, nil
+		}
+		return []comparator{{">=", lower}, {"<", Version{Major: p.Major, Minor: p.Minor + 1}}}, nil
+	case "^":
+		var upper Version
+		switch {
+		case p.parts == 0:
+			return all, nil
+		case p.Major > 0 || p.parts == 1:
+			upper = Version{Major: p.Major + 1}
+		case p.Minor > 0 || p.parts == 2:
+			upper = Version{Minor: p.Minor + 1}
+		default:
+			upper = Version{Patch: p.Patch + 1}
+		}
+		return []comparator{{">=", lower}, {"<", upper}}, nil
+	}
+	return nil, fmt.Errorf("unknown operator '%s'", op)
+}
+
+// Reports whether a version satisfies the constraint.
+func (c Constraint) Check(v Version) bool {
+	for _, set := range c.sets {
+		if checkSet(set, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkSet(set []comparator, v Version) bool {
+	prereleaseAllowed := len(v.Prerelease) == 0
+	for _, cmp := range set {
+		if !cmp.check(v) {
+			return false
+		}
+
+		// Prereleases are only allowed if they're explicitly asked for
+		if len(cmp.v.Prerelease) > 0 && cmp.v.Major == v.Major && cmp.v.Minor == v.Minor && cmp.v.Patch == v.Patch {
+			prereleaseAllowed = true
+		}
+	}
+	return prereleaseAllowed
+}
+
+func (cmp comparator) check(v Version) bool {
+	c := v.Compare(cmp.v)
+	switch cmp.op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	}
+	return false
+}
+
+func (c Constraint) String() string {
+	return c.str
+}
@@ -0,0 +1,236 @@
+// Package semver contains rules for semantic versions (https://semver.org)
+// and version constraints such as ">=1.2.0 <2.0.0".
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("SemVer", SemVer)
+	rules.Add("SemVerConstraint", SemVerConstraint)
+	rules.Add("SemVerSatisfies", SemVerSatisfies)
+}
+
+// The regular expression suggested by the SemVer 2.0.0 specification
+var rxSemVer = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// A semantic version
+type Version struct {
+	Major, Minor, Patch uint64
+
+	// The dot separated prerelease identifiers, eg. ["rc", "1"] for "-rc.1"
+	Prerelease []string
+
+	// Build metadata, which is ignored when comparing versions
+	Build string
+}
+
+// Parses a SemVer 2.0.0 version such as "1.2.3", "1.0.0-rc.1" or
+// "1.0.0+20240301".
+func Parse(str string) (Version, error) {
+	match := rxSemVer.FindStringSubmatch(str)
+	if match == nil {
+		return Version{}, fmt.Errorf("Invalid semantic version '%s'", str)
+	}
+
+	var v Version
+	var err error
+	for i, n := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		if *n, err = strconv.ParseUint(match[i+1], 10, 64); err != nil {
+			return Version{}, fmt.Errorf("Invalid semantic version '%s'", str)
+		}
+	}
+	if match[4] != "" {
+		v.Prerelease = strings.Split(match[4], ".")
+	}
+	v.Build = match[5]
+	return v, nil
+}
+
+func (v Version) String() string {
+	str := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		str += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if v.Build != "" {
+		str += "+" + v.Build
+	}
+	return str
+}
+
+// Compares versions by precedence, returning -1, 0 or 1 if v is lower than,
+// equal to or higher than o. Build metadata is ignored.
+func (v Version) Compare(o Version) int {
+	for _, pair := range [][2]uint64{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if c := compareUint(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+
+	// A version without a prerelease has higher precedence than one with
+	switch {
+	case len(v.Prerelease) == 0 && len(o.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(o.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(o.Prerelease); i++ {
+		if c := compareIdentifier(v.Prerelease[i], o.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.Prerelease)), uint64(len(o.Prerelease)))
+}
+
+// Numeric identifiers are compared numerically and have lower precedence
+// than alphanumeric identifiers, which are compared in ASCII order
+func compareIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Passes if the data is a SemVer 2.0.0 version, such as "1.2.3" or
+// "2.0.0-rc.1+build.5". 'SemVer:prefix' also accepts a leading "v".
+func SemVer(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if len(data.Args) > 0 {
+		if data.Args[0] != "prefix" {
+			return fmt.Errorf("Unknown SemVer argument '%s': expected prefix", data.Args[0])
+		}
+		v = strings.TrimPrefix(v, "v")
+	}
+
+	if _, err := Parse(v); err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid semantic version",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a version constraint, such as ">=1.2.0 <2.0.0",
+// "^1.4" or "~1.2.3 || >=2.1". See ParseConstraint for the syntax.
+func SemVerConstraint(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if _, err := ParseConstraint(v); err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid version constraint",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a version satisfying a constraint. The constraint is
+// either given in the tag, eg. 'SemVerSatisfies:>=1.2.0 <2.0.0', or read from
+// another field, eg. 'SemVerSatisfies:field=Requires'. If the other field is
+// empty the version isn't checked.
+//
+// As with npm, prerelease versions only satisfy a constraint which names a
+// prerelease of the same major, minor and patch version, so "2.0.0-beta"
+// doesn't satisfy ">=1.0.0" but does satisfy ">=2.0.0-alpha".
+func SemVerSatisfies(data rules.ValidationData) error {
+	// We should always be provided with a constraint to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'SemVerSatisfies:^1.2.0')")
+	}
+
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	version, err := Parse(v)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid semantic version",
+		}
+	}
+
+	str := data.Args[0]
+	name, fromField := rules.FieldArg(str)
+	if fromField {
+		sibling, err := data.Sibling(name)
+		if err != nil {
+			return err
+		}
+		if str, err = helper.ToString(sibling); err != nil {
+			return fmt.Errorf("Field '%s' can't take its constraint from '%s': it is not a string", data.Field, name)
+		}
+		if str == "" {
+			return nil
+		}
+	}
+
+	constraint, err := ParseConstraint(str)
+	if err != nil {
+		if !fromField {
+			return err
+		}
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("can't be checked against the invalid constraint in %s", name),
+		}
+	}
+
+	if !constraint.Check(version) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must satisfy '%s'", str),
+		}
+	}
+
+	return nil
+}
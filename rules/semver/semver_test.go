@@ -0,0 +1,146 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestSemVer(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{SemVer, "SemVer", nil, []interface{}{"0.0.0", "1.2.3", "10.20.30", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-0.3.7", "1.0.0-x.7.z.92", "1.0.0+20130313144700", "1.0.0-beta+exp.sha.5114f85", "1.0.0-alpha-a.b-c"}, []interface{}{"1", "1.2", "v1.2.3", "01.2.3", "1.02.3", "1.2.3-01", "1.2.3-", "1.2.3+", "1.2.3-alpha..1", "1.2.3.4", "", 1}},
+		{SemVer, "SemVer", []string{"prefix"}, []interface{}{"v1.2.3", "1.2.3"}, []interface{}{"vv1.2.3", "V1.2.3"}},
+		{SemVerConstraint, "SemVerConstraint", nil, []interface{}{">=1.2.0 <2.0.0", "^1.4", "~1.2.3 || >=2.1", "1.2.x", "*", ">= 1.2", "1.2 - 1.4", "=1.2.3, !=1.2.4", "v1.2.3", "^0.0.1-beta"}, []interface{}{"", "||", ">=1.2.0 ||", "1.2.3.4", "=>1.2", "!=1.2", "1.x.3", "1.2-beta", ">*", "01.2", "latest"}},
+		{SemVerSatisfies, "SemVerSatisfies", []string{">=1.2.0 <2.0.0"}, []interface{}{"1.2.0", "1.9.9", "1.5.0+build"}, []interface{}{"1.1.9", "2.0.0", "2.0.0-beta", "1.5.0-rc.1", "v1.5.0"}},
+		{SemVerSatisfies, "SemVerSatisfies", []string{"^1.2.3-beta.2"}, []interface{}{"1.2.3-beta.2", "1.2.3-beta.10", "1.2.3", "1.9.0"}, []interface{}{"1.2.3-beta.1", "1.2.4-beta", "2.0.0"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %q: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %q to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// Ordered by precedence, from the SemVer 2.0.0 specification
+	versions := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0"}
+
+	for i := range versions {
+		for j := range versions {
+			a, _ := Parse(versions[i])
+			b, _ := Parse(versions[j])
+
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			if c := a.Compare(b); c != expected {
+				t.Errorf("Expected %s compared to %s to be %d, got %d", a, b, expected, c)
+			}
+		}
+	}
+
+	a, _ := Parse("1.0.0+build.1")
+	b, _ := Parse("1.0.0+build.2")
+	if a.Compare(b) != 0 {
+		t.Errorf("Expected build metadata to be ignored")
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	var tests = []struct {
+		Constraint  string
+		Satisfied   []string
+		Unsatisfied []string
+	}{
+		{"1.2.x", []string{"1.2.0", "1.2.99"}, []string{"1.3.0", "1.1.9"}},
+		{"1", []string{"1.0.0", "1.99.0"}, []string{"2.0.0", "0.9.0"}},
+		{"*", []string{"0.0.0", "99.0.0"}, []string{"1.0.0-beta"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.3.0", "1.2.2"}},
+		{"~1", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0", "0.2.2"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"^0", []string{"0.0.0", "0.9.9"}, []string{"1.0.0"}},
+		{">1.2", []string{"1.3.0"}, []string{"1.2.9"}},
+		{"<=1.2", []string{"1.2.9"}, []string{"1.3.0"}},
+		{"<1.2", []string{"1.1.9"}, []string{"1.2.0"}},
+		{"1.2 - 1.4", []string{"1.2.0", "1.4.9"}, []string{"1.1.9", "1.5.0"}},
+		{"1.2.3 - 2.3.4", []string{"1.2.3", "2.3.4"}, []string{"2.3.5"}},
+		{"^1.2 || ^3.0", []string{"1.5.0", "3.1.0"}, []string{"2.0.0", "4.0.0"}},
+		{">=1.0.0, !=1.2.4", []string{"1.2.3", "1.2.5"}, []string{"1.2.4"}},
+	}
+
+	for _, test := range tests {
+		c, err := ParseConstraint(test.Constraint)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %s", test.Constraint, err)
+			continue
+		}
+
+		for _, str := range test.Satisfied {
+			if v, _ := Parse(str); !c.Check(v) {
+				t.Errorf("Expected %s to satisfy %q", str, test.Constraint)
+			}
+		}
+		for _, str := range test.Unsatisfied {
+			if v, _ := Parse(str); c.Check(v) {
+				t.Errorf("Expected %s not to satisfy %q", str, test.Constraint)
+			}
+		}
+	}
+}
+
+func TestSemVerSatisfiesField(t *testing.T) {
+	type Manifest struct {
+		Version  string
+		Requires string
+	}
+
+	var tests = []struct {
+		Manifest Manifest
+		Valid    bool
+	}{
+		{Manifest{"1.4.0", ">=1.2.0 <2.0.0"}, true},
+		{Manifest{"2.1.0", ">=1.2.0 <2.0.0"}, false},
+		{Manifest{"2.1.0", ""}, true},
+		{Manifest{"2.1.0", "not a constraint"}, false},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field:  "Version",
+			Value:  test.Manifest.Version,
+			Args:   []string{"field=Requires"},
+			Struct: reflect.ValueOf(test.Manifest),
+		}
+
+		if err := SemVerSatisfies(object); (err == nil) != test.Valid {
+			t.Errorf("Unexpected result validating %v: %v", test.Manifest, err)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Package strings contains rules checking the content of strings: substrings,
+// prefixes, suffixes, case and surrounding whitespace.
+//
+// Contains, StartsWith, EndsWith and Excludes take the text to look for as
+// their argument. Add ':nocase' to compare using Unicode case folding, eg.
+// 'StartsWith:https:nocase'. Quote the text to include commas or a trailing
+// ':nocase':
+//
+//	`validate:"Contains:', Inc', NotEmpty"`
+package strings
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Contains", Contains)
+	rules.Add("StartsWith", StartsWith)
+	rules.Add("EndsWith", EndsWith)
+	rules.Add("Excludes", Excludes)
+	rules.Add("Lowercase", Lowercase)
+	rules.Add("Uppercase", Uppercase)
+	rules.Add("Trimmed", Trimmed)
+}
+
+// Passes if the data contains the text given, eg. 'Contains:@'.
+func Contains(data rules.ValidationData) error {
+	return match(data, "Contains", strings.Contains, true, "must contain %q")
+}
+
+// Passes if the data starts with the text given, eg. 'StartsWith:sk_'.
+func StartsWith(data rules.ValidationData) error {
+	return match(data, "StartsWith", strings.HasPrefix, true, "must start with %q")
+}
+
+// Passes if the data ends with the text given, eg. 'EndsWith:.pdf:nocase'.
+func EndsWith(data rules.ValidationData) error {
+	return match(data, "EndsWith", strings.HasSuffix, true, "must end with %q")
+}
+
+// Passes if the data doesn't contain the text given, eg. 'Excludes:admin'.
+func Excludes(data rules.ValidationData) error {
+	return match(data, "Excludes", strings.Contains, false, "must not contain %q")
+}
+
+func match(data rules.ValidationData, rule string, fn func(s, substr string) bool, want bool, failure string) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	substr, nocase, err := parseArg(data, rule)
+	if err != nil {
+		return err
+	}
+
+	s, expected := v, substr
+	if nocase {
+		s, expected = fold(v), fold(substr)
+	}
+
+	if fn(s, expected) != want {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf(failure, substr),
+		}
+	}
+
+	return nil
+}
+
+// Returns the text a rule looks for and whether to ignore case
+func parseArg(data rules.ValidationData, rule string) (substr string, nocase bool, err error) {
+	// We should always be provided with the text to look for
+	if len(data.Args) == 0 || data.Args[0] == "" {
+		return "", false, fmt.Errorf("No argument found in the validation struct (eg '%s:text')", rule)
+	}
+
+	args := data.SplitArgs()
+	arg := args[0]
+	if strings.HasPrefix(arg, "'") {
+		return "", false, fmt.Errorf("Unterminated quote in %s argument %s", rule, data.Args[0])
+	}
+
+	for _, a := range args[1:] {
+		if a != "nocase" {
+			return "", false, fmt.Errorf("Unknown %s argument '%s': expected nocase", rule, a)
+		}
+		nocase = true
+	}
+
+	if arg == "" {
+		return "", false, fmt.Errorf("No argument found in the validation struct (eg '%s:text')", rule)
+	}
+	return arg, nocase, nil
+}
+
+// Maps every rune to the smallest rune it's equivalent to under Unicode
+// simple case folding, so that "Résumé" and "RÉSUMÉ" fold the same. Full
+// case folding, where "ß" matches "SS", isn't supported.
+func fold(str string) string {
+	return strings.Map(func(r rune) rune {
+		min := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if f < min {
+				min = f
+			}
+		}
+		return min
+	}, str)
+}
+
+// Passes if the data has no upper or title case letters. Strings without
+// letters, such as "123", pass.
+func Lowercase(data rules.ValidationData) error {
+	return checkCase(data, func(r rune) bool { return unicode.IsUpper(r) || unicode.IsTitle(r) }, "must be lower case")
+}
+
+// Passes if the data has no lower or title case letters. Strings without
+// letters, such as "123", pass.
+func Uppercase(data rules.ValidationData) error {
+	return checkCase(data, func(r rune) bool { return unicode.IsLower(r) || unicode.IsTitle(r) }, "must be upper case")
+}
+
+func checkCase(data rules.ValidationData, invalid func(rune) bool, failure string) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if strings.IndexFunc(v, invalid) >= 0 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        failure,
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data has no leading or trailing whitespace, including Unicode
+// spaces such as the no-break space.
+func Trimmed(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if v != strings.TrimSpace(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "must not start or end with whitespace",
+		}
+	}
+
+	return nil
+}
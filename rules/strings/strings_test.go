@@ -0,0 +1,67 @@
+package strings
+
+import (
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestStrings(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{Contains, "Contains", []string{"@"}, []interface{}{"a@b", "@", []byte("x@")}, []interface{}{"ab", "", 1}},
+		{Contains, "Contains", []string{"', Inc'"}, []interface{}{"Acme, Inc."}, []interface{}{"Acme Inc."}},
+		{Contains, "Contains", []string{"'a:nocase'"}, []interface{}{"xa:nocase"}, []interface{}{"A"}},
+		{Contains, "Contains", []string{"'http://':nocase"}, []interface{}{"HTTP://example.com"}, []interface{}{"http:"}},
+		{Contains, "Contains", []string{"résumé:nocase"}, []interface{}{"My RÉSUMÉ", "résumé"}, []interface{}{"resume"}},
+		{Contains, "Contains", []string{"'Σ':nocase"}, []interface{}{"ΌΣΟΣ", "όσος", "όσοσ"}, []interface{}{"osos"}},
+		{StartsWith, "StartsWith", []string{"sk_"}, []interface{}{"sk_live_123"}, []interface{}{"pk_live_123", "SK_live"}},
+		{StartsWith, "StartsWith", []string{"https:nocase"}, []interface{}{"HTTPS://example.com", "https://"}, []interface{}{"http://example.com"}},
+		{EndsWith, "EndsWith", []string{".pdf"}, []interface{}{"report.pdf"}, []interface{}{"report.PDF", "report.pdf.exe"}},
+		{EndsWith, "EndsWith", []string{".pdf:nocase"}, []interface{}{"report.PDF"}, []interface{}{"report.doc"}},
+		{Excludes, "Excludes", []string{"admin"}, []interface{}{"user", "Admin"}, []interface{}{"admin", "superadmin"}},
+		{Excludes, "Excludes", []string{"admin:nocase"}, []interface{}{"user"}, []interface{}{"ADMIN"}},
+		{Lowercase, "Lowercase", nil, []interface{}{"hello", "café", "123", "", "ß"}, []interface{}{"Hello", "CAFÉ", "ǅ"}},
+		{Uppercase, "Uppercase", nil, []interface{}{"HELLO", "CAFÉ", "123", ""}, []interface{}{"Hello", "café", "ǅ"}},
+		{Trimmed, "Trimmed", nil, []interface{}{"hello", "hello world", ""}, []interface{}{" hello", "hello ", "\thello", "hello\n", " hello"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %q: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %q to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+
+	for _, args := range [][]string{nil, {""}, {"''"}, {"'abc"}, {"'abc':other"}, {"a:b"}} {
+		if err := Contains(rules.ValidationData{Field: "Test", Value: "abc", Args: args}); err == nil {
+			t.Errorf("Expected Contains%v to return an error", args)
+		}
+	}
+}
+
+func TestFailureQuotesText(t *testing.T) {
+	err := StartsWith(rules.ValidationData{Field: "Key", Value: "pk_123", Args: []string{"sk_"}})
+	if err == nil || err.Error() != `Field 'Key' must start with "sk_"` {
+		t.Errorf("Expected the failure to quote the prefix, got %v", err)
+	}
+}
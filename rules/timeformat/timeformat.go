@@ -0,0 +1,313 @@
+// Package timeformat contains rules for strings holding dates, times,
+// durations and time zone names.
+package timeformat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata" // Embed the time zone database so IANATimezone works everywhere
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("DateTime", DateTime)
+	rules.Add("RFC3339", RFC3339)
+	rules.Add("IANATimezone", IANATimezone)
+	rules.Add("Duration", Duration)
+	rules.Add("ISO8601Duration", ISO8601Duration)
+}
+
+// Named layouts which can be used in place of a Go layout, eg. 'DateTime:DateOnly'
+var layouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+}
+
+// Passes if the data is a string which parses with the Go time layout given in
+// the tag, eg. 'DateTime:2006-01-02' or 'DateTime:15:04'. The name of one of
+// the layout constants in the time package can be used instead, eg.
+// 'DateTime:DateOnly' or 'DateTime:RFC1123'.
+//
+// A time zone can be given after the layout with ':tz=', eg.
+// 'DateTime:2006-01-02T15:04:05Z07:00:tz=Europe/London'. If the layout has a
+// zone offset the offset must be correct for that time zone at the given
+// time, so "2024-07-01T12:00:00+00:00" fails as London is on BST in July.
+// Without a time zone any offset is allowed, and times without an offset are
+// parsed as UTC.
+func DateTime(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	// We should always be provided with a layout to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'DateTime:2006-01-02')")
+	}
+
+	layout, loc, err := parseLayout(data.Args[0])
+	if err != nil {
+		return err
+	}
+
+	in := loc
+	if in == nil {
+		in = time.UTC
+	}
+
+	t, err := time.ParseInLocation(layout, v, in)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a valid date/time in the format '%s'", layout),
+		}
+	}
+
+	// Any offset is allowed unless a time zone is given
+	if loc == nil {
+		return nil
+	}
+
+	if _, offset := t.Zone(); offset != offsetIn(t, loc) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("has the wrong offset for the %s time zone", loc),
+		}
+	}
+
+	return nil
+}
+
+// Splits the layout from an optional time zone argument. loc is nil if there's
+// no time zone.
+func parseLayout(arg string) (layout string, loc *time.Location, err error) {
+	layout = arg
+
+	if i := strings.LastIndex(arg, ":tz="); i >= 0 {
+		layout = arg[:i]
+		if loc, err = time.LoadLocation(arg[i+len(":tz="):]); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if named, ok := layouts[layout]; ok {
+		layout = named
+	}
+	return layout, loc, nil
+}
+
+func offsetIn(t time.Time, loc *time.Location) int {
+	_, offset := t.In(loc).Zone()
+	return offset
+}
+
+// Passes if the data is a timestamp in RFC 3339 format, such as
+// "2024-03-01T14:30:00Z" or "2024-03-01T14:30:00.123+01:00".
+func RFC3339(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if _, err := time.Parse(time.RFC3339, v); err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid RFC 3339 timestamp",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is the name of a time zone in the IANA time zone
+// database, such as "Europe/London" or "UTC". The database is embedded via
+// time/tzdata so this doesn't depend on the host's zoneinfo files.
+func IANATimezone(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	// LoadLocation treats "" as UTC and "Local" as the system time zone,
+	// neither of which are IANA names
+	if v == "" || v == "Local" {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid IANA time zone",
+		}
+	}
+
+	if _, err := time.LoadLocation(v); err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid IANA time zone",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a time.Duration or a string accepted by
+// time.ParseDuration, such as "1h30m" or "300ms". Optional bounds can be
+// given, eg. 'Duration:min=1s:max=24h'.
+func Duration(data rules.ValidationData) error {
+	var d time.Duration
+	if v, ok := data.Value.(time.Duration); ok {
+		d = v
+	} else {
+		v, err := helper.ToString(data.Value)
+		if err != nil {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "is not a string",
+			}
+		}
+
+		if d, err = time.ParseDuration(v); err != nil {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "is not a valid duration",
+			}
+		}
+	}
+
+	for _, arg := range data.SplitArgs() {
+		i := strings.Index(arg, "=")
+		if i < 0 {
+			return fmt.Errorf("Unknown Duration argument '%s' (eg 'Duration:min=1s:max=24h')", arg)
+		}
+
+		bound, err := time.ParseDuration(arg[i+1:])
+		if err != nil {
+			return err
+		}
+
+		switch arg[:i] {
+		case "min":
+			if d < bound {
+				return rules.ErrInvalid{
+					ValidationData: data,
+					Failure:        fmt.Sprintf("must be at least %s", bound),
+				}
+			}
+		case "max":
+			if d > bound {
+				return rules.ErrInvalid{
+					ValidationData: data,
+					Failure:        fmt.Sprintf("must be at most %s", bound),
+				}
+			}
+		default:
+			return fmt.Errorf("Unknown Duration argument '%s' (eg 'Duration:min=1s:max=24h')", arg)
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is an ISO 8601 duration, such as "P1Y2M3DT4H5M6S",
+// "PT0.5S" or "P2W". Only the smallest unit given may have a fraction.
+func ISO8601Duration(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !IsISO8601Duration(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid ISO 8601 duration",
+		}
+	}
+
+	return nil
+}
+
+// Reports whether str is an ISO 8601 duration of the form PnYnMnDTnHnMnS or
+// PnW.
+func IsISO8601Duration(str string) bool {
+	if !strings.HasPrefix(str, "P") {
+		return false
+	}
+	str = str[1:]
+
+	date, clock := str, ""
+	hasTime := false
+	if i := strings.IndexByte(str, 'T'); i >= 0 {
+		date, clock, hasTime = str[:i], str[i+1:], true
+	}
+
+	if strings.HasSuffix(date, "W") && !hasTime {
+		return isComponents(date, "W")
+	}
+
+	if hasTime && clock == "" {
+		return false
+	}
+	if date == "" && clock == "" {
+		return false
+	}
+
+	// A fraction is only allowed in the last component of the whole duration
+	if hasTime && strings.ContainsAny(date, ".,") {
+		return false
+	}
+
+	return isComponents(date, "YMD") && isComponents(clock, "HMS")
+}
+
+// Reports whether str is a sequence of numbers each followed by a designator,
+// with the designators in the given order. Only the last number may have a
+// fraction.
+func isComponents(str, designators string) bool {
+	for str != "" {
+		i := strings.IndexFunc(str, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if i <= 0 {
+			return false
+		}
+
+		number, designator := str[:i], str[i]
+		str = str[i+1:]
+
+		if strings.ContainsAny(number, ".,") {
+			parts := strings.FieldsFunc(number, func(r rune) bool { return r == '.' || r == ',' })
+			if len(parts) != 2 || strings.Count(number, ".")+strings.Count(number, ",") != 1 || str != "" || number[0] == '.' || number[0] == ',' || number[len(number)-1] == '.' || number[len(number)-1] == ',' {
+				return false
+			}
+		}
+
+		j := strings.IndexByte(designators, designator)
+		if j < 0 {
+			return false
+		}
+		designators = designators[j+1:]
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package timeformat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestTimeFormats(t *testing.T) {
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{DateTime, "DateTime", []string{"2006-01-02"}, []interface{}{"2024-03-01", []byte("2024-02-29")}, []interface{}{"2023-02-29", "2024-3-1", "01/03/2024", 1}},
+		{DateTime, "DateTime", []string{"15:04"}, []interface{}{"14:30", "00:00"}, []interface{}{"24:00", "2:30pm"}},
+		{DateTime, "DateTime", []string{"DateOnly"}, []interface{}{"2024-03-01"}, []interface{}{"2024-03-01T00:00:00Z"}},
+		{DateTime, "DateTime", []string{"RFC3339"}, []interface{}{"2024-03-01T14:30:00Z", "2024-03-01T14:30:00+01:00", "2024-03-01T14:30:00-08:00"}, []interface{}{"2024-03-01 14:30:00"}},
+		{DateTime, "DateTime", []string{"RFC1123Z"}, []interface{}{"Fri, 01 Mar 2024 14:30:00 +0530"}, []interface{}{"Fri, 01 Mar 2024 14:30:00 IST"}},
+		{DateTime, "DateTime", []string{"2006-01-02T15:04Z07:00"}, []interface{}{"2024-03-01T14:30+09:00"}, []interface{}{"2024-03-01T14:30"}},
+		{DateTime, "DateTime", []string{"2006-01-02T15:04:05Z07:00:tz=Europe/London"}, []interface{}{"2024-01-01T12:00:00Z", "2024-07-01T12:00:00+01:00"}, []interface{}{"2024-07-01T12:00:00Z", "2024-01-01T12:00:00+01:00"}},
+		{DateTime, "DateTime", []string{"2006-01-02 15:04:tz=America/New_York"}, []interface{}{"2024-07-01 12:00"}, []interface{}{"2024-07-01T12:00"}},
+		{RFC3339, "RFC3339", nil, []interface{}{"2024-03-01T14:30:00Z", "2024-03-01T14:30:00.123+01:00"}, []interface{}{"2024-03-01", "2024-03-01 14:30:00Z", "2024-03-01T14:30:00"}},
+		{IANATimezone, "IANATimezone", nil, []interface{}{"Europe/London", "America/Argentina/Buenos_Aires", "UTC", "Asia/Kolkata"}, []interface{}{"", "Local", "Europe/Nowhere", "../etc/passwd", "GMT+25", 1}},
+		{Duration, "Duration", nil, []interface{}{"1h30m", "300ms", "-5s", "0", 5 * time.Second}, []interface{}{"", "1d", "5", "PT1H"}},
+		{Duration, "Duration", []string{"min=1s:max=24h"}, []interface{}{"1s", "24h", time.Hour}, []interface{}{"999ms", "25h", -time.Hour}},
+		{ISO8601Duration, "ISO8601Duration", nil, []interface{}{"P1Y2M3DT4H5M6S", "PT0.5S", "P2W", "P1D", "PT1M", "P0,5Y", "PT36H", "P1M"}, []interface{}{"P", "PT", "P1", "1Y", "P1S", "PT1D", "P1DT", "P1.5DT1H", "P1.5Y2M", "P1W2D", "PM1Y", "P1..5Y", "p1y"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s%v value %v: %s", test.Name, test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s%v value %v to fail validation", test.Name, test.Args, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Package times contains rules comparing time.Time (and *time.Time) fields
+// against fixed timestamps, the current time or other fields.
+//
+// Rules which compare against a time take one of the following arguments:
+//
+//	2020-01-01T00:00:00Z  an RFC 3339 timestamp
+//	now                   the current time
+//	-720h, 24h            a duration relative to the current time
+//	field=StartsAt        the value of another time field in the struct
+//
+// The current time comes from the validator's Now option, so tests can use a
+// fixed clock:
+//
+//	v := validate.Validator{}
+//	v.Now = func() time.Time { return fixed }
+package times
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Before", Before)
+	rules.Add("After", After)
+	rules.Add("Within", Within)
+	rules.Add("InFuture", InFuture)
+	rules.Add("InPast", InPast)
+}
+
+// Passes if the data is a time strictly before the time in the tag, eg.
+// 'Before:2030-01-01T00:00:00Z', 'Before:-24h' or 'Before:field=EndsAt'.
+func Before(data rules.ValidationData) error {
+	return compare(data, "Before", func(t, other time.Time) bool { return t.Before(other) }, "must be before %s")
+}
+
+// Passes if the data is a time strictly after the time in the tag, eg.
+// 'After:2020-01-01T00:00:00Z', 'After:-720h' or 'After:field=StartsAt'.
+func After(data rules.ValidationData) error {
+	return compare(data, "After", func(t, other time.Time) bool { return t.After(other) }, "must be after %s")
+}
+
+// Passes if the data is a time after the current time.
+func InFuture(data rules.ValidationData) error {
+	data.Args = []string{"now"}
+	return compare(data, "InFuture", func(t, now time.Time) bool { return t.After(now) }, "must be in the future")
+}
+
+// Passes if the data is a time before the current time.
+func InPast(data rules.ValidationData) error {
+	data.Args = []string{"now"}
+	return compare(data, "InPast", func(t, now time.Time) bool { return t.Before(now) }, "must be in the past")
+}
+
+// Passes if the data is a time within a window around the current time.
+// 'Within:720h' allows times up to 720 hours either side of now, and
+// 'Within:-720h,24h' allows times from 720 hours ago until 24 hours from now.
+func Within(data rules.ValidationData) error {
+	t, err := helper.ToTime(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a Time type",
+		}
+	}
+
+	// We should always be provided with a window to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg 'Within:720h')")
+	}
+
+	var from, to time.Duration
+	bounds := strings.SplitN(data.Args[0], ",", 2)
+	if to, err = time.ParseDuration(strings.TrimSpace(bounds[0])); err != nil {
+		return err
+	}
+	from = -to
+	if len(bounds) == 2 {
+		from = to
+		if to, err = time.ParseDuration(strings.TrimSpace(bounds[1])); err != nil {
+			return err
+		}
+	}
+	if from > to {
+		from, to = to, from
+	}
+
+	now := data.Options.Time()
+	if t.Before(now.Add(from)) || t.After(now.Add(to)) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must be between %s and %s", now.Add(from).Format(time.RFC3339), now.Add(to).Format(time.RFC3339)),
+		}
+	}
+
+	return nil
+}
+
+// Compares the data with the time in the tag using fn. The failure may
+// include a %s verb for the time being compared against.
+func compare(data rules.ValidationData, rule string, fn func(t, other time.Time) bool, failure string) error {
+	t, err := helper.ToTime(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a Time type",
+		}
+	}
+
+	// We should always be provided with a time to validate against
+	if len(data.Args) == 0 {
+		return fmt.Errorf("No argument found in the validation struct (eg '%s:2020-01-01T00:00:00Z')", rule)
+	}
+
+	other, ok, err := resolve(data, data.Args[0])
+	if err != nil {
+		return err
+	}
+	if !ok || fn(t, other) {
+		return nil
+	}
+
+	if strings.Contains(failure, "%s") {
+		failure = fmt.Sprintf(failure, describe(data.Args[0], other))
+	}
+	return rules.ErrInvalid{
+		ValidationData: data,
+		Failure:        failure,
+	}
+}
+
+// Resolves a tag argument into a time. If the argument refers to a field
+// holding a nil *time.Time ok is false, as there's nothing to compare against.
+func resolve(data rules.ValidationData, arg string) (t time.Time, ok bool, err error) {
+	if name, isField := rules.FieldArg(arg); isField {
+		sibling, err := data.Sibling(name)
+		if err != nil {
+			return t, false, err
+		}
+		if ptr, isPtr := sibling.(*time.Time); isPtr && ptr == nil {
+			return t, false, nil
+		}
+		if t, err = helper.ToTime(sibling); err != nil {
+			return t, false, fmt.Errorf("Field '%s' can't be compared with '%s': it is not a Time type", data.Field, name)
+		}
+		return t, true, nil
+	}
+
+	if arg == "now" {
+		return data.Options.Time(), true, nil
+	}
+
+	if d, err := time.ParseDuration(arg); err == nil {
+		return data.Options.Time().Add(d), true, nil
+	}
+
+	if t, err = time.Parse(time.RFC3339, arg); err != nil {
+		return t, false, fmt.Errorf("Invalid time '%s': expected an RFC 3339 timestamp, 'now', a duration or 'field=Name'", arg)
+	}
+	return t, true, nil
+}
+
+// Describes the time being compared against for failure messages
+func describe(arg string, t time.Time) string {
+	if name, ok := rules.FieldArg(arg); ok {
+		return name
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,60 @@
+package times
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func TestTimes(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	hour := time.Hour
+	past, future := now.Add(-hour), now.Add(hour)
+
+	var tests = []struct {
+		Rule    rules.ValidatorFunc
+		Name    string
+		Arg     string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{Before, "Before", "2020-06-01T12:00:00Z", []interface{}{past, &past}, []interface{}{now, future, (*time.Time)(nil), "2020-01-01"}},
+		{Before, "Before", "-30m", []interface{}{past}, []interface{}{now}},
+		{After, "After", "2020-01-01T00:00:00+01:00", []interface{}{now}, []interface{}{time.Date(2019, 12, 31, 23, 0, 0, 0, time.UTC)}},
+		{After, "After", "now", []interface{}{future}, []interface{}{now, past}},
+		{InFuture, "InFuture", "", []interface{}{future, &future}, []interface{}{now, past}},
+		{InPast, "InPast", "", []interface{}{past}, []interface{}{now, future}},
+		{Within, "Within", "2h", []interface{}{past, now, future}, []interface{}{now.Add(-3 * hour), now.Add(3 * hour)}},
+		{Within, "Within", "-720h,0s", []interface{}{past, now, now.Add(-720 * hour)}, []interface{}{future, now.Add(-721 * hour)}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  []string{test.Arg},
+			Options: rules.Options{
+				Now: func() time.Time { return now },
+			},
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := test.Rule(object); err != nil {
+				t.Errorf("Unexpected error with valid %s:%s value %v: %s", test.Name, test.Arg, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := test.Rule(object); err == nil {
+				t.Errorf("Expected invalid %s:%s value %v to fail validation", test.Name, test.Arg, v)
+			}
+		}
+	}
+
+	object := rules.ValidationData{Field: "Test", Value: now, Args: []string{"tomorrow"}}
+	if _, ok := Before(object).(rules.ErrInvalid); ok {
+		t.Errorf("Expected an invalid argument to return a non-validation error")
+	}
+}
@@ -0,0 +1,53 @@
+package token
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// Passes if the data is a hex encoded MD5 digest: 32 hexadecimal digits, in
+// either case.
+func MD5(data rules.ValidationData) error {
+	return digest(data, "MD5", 16)
+}
+
+// Passes if the data is a hex encoded SHA-1 digest: 40 hexadecimal digits,
+// in either case.
+func SHA1(data rules.ValidationData) error {
+	return digest(data, "SHA-1", 20)
+}
+
+// Passes if the data is a hex encoded SHA-256 digest: 64 hexadecimal digits,
+// in either case.
+func SHA256(data rules.ValidationData) error {
+	return digest(data, "SHA-256", 32)
+}
+
+// Passes if the data is a hex encoded SHA-512 digest: 128 hexadecimal
+// digits, in either case.
+func SHA512(data rules.ValidationData) error {
+	return digest(data, "SHA-512", 64)
+}
+
+// Checks that the data is the hex encoding of a digest of size bytes
+func digest(data rules.ValidationData, name string, size int) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if len(v) != size*2 || strings.Trim(v, "0123456789abcdefABCDEF") != "" {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is not a hex encoded %s digest", name),
+		}
+	}
+
+	return nil
+}
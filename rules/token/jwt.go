@@ -0,0 +1,204 @@
+package token
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// The signing algorithms JWT allows by default: every JWS algorithm in RFC
+// 7518 and RFC 8037 except "none".
+var DefaultAlgorithms = []string{
+	"HS256", "HS384", "HS512",
+	"RS256", "RS384", "RS512",
+	"ES256", "ES384", "ES512",
+	"PS256", "PS384", "PS512",
+	"EdDSA",
+}
+
+// Passes if the data is a JSON Web Token in compact form: a base64url JSON
+// header, a base64url JSON claims set and a base64url signature, separated by
+// dots. The signature is not verified. Takes the following arguments, which
+// can be combined:
+//
+//	alg=HS256|RS256  the algorithms allowed in the header, instead of
+//	                 DefaultAlgorithms. Unsigned tokens are only allowed
+//	                 by listing "none"
+//	exp              require an exp claim which hasn't passed
+//	nbf              check that the nbf claim, if any, has passed
+//	skew=30s         the clock skew allowed when checking exp and nbf
+//
+// The current time comes from the validator's Now option, eg.
+// 'JWT:alg=RS256:exp:nbf:skew=1m'.
+func JWT(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	algs := DefaultAlgorithms
+	var exp, nbf bool
+	var skew time.Duration
+	for _, arg := range data.SplitArgs() {
+		switch {
+		case strings.HasPrefix(arg, "alg="):
+			algs = strings.Split(arg[len("alg="):], "|")
+		case strings.HasPrefix(arg, "skew="):
+			if skew, err = time.ParseDuration(arg[len("skew="):]); err != nil {
+				return fmt.Errorf("Invalid JWT skew '%s'", arg[len("skew="):])
+			}
+		case arg == "exp":
+			exp = true
+		case arg == "nbf":
+			nbf = true
+		default:
+			return fmt.Errorf("Unknown JWT argument '%s': expected alg=, exp, nbf or skew=", arg)
+		}
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a JWT",
+		}
+	}
+
+	var header struct {
+		Alg *string `json:"alg"`
+	}
+	var claims map[string]interface{}
+	if decodeJSON(parts[0], &header) != nil || decodeJSON(parts[1], &claims) != nil || claims == nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a JWT",
+		}
+	}
+	if _, err := base64.RawURLEncoding.Strict().DecodeString(parts[2]); err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a JWT",
+		}
+	}
+
+	if header.Alg == nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "has no alg in its header",
+		}
+	}
+
+	alg := *header.Alg
+	allowed := false
+	for _, a := range algs {
+		allowed = allowed || a == alg
+	}
+	if !allowed {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("has an algorithm '%s' which isn't allowed", alg),
+		}
+	}
+
+	// An unsecured JWT has an empty signature, and any other has a signature
+	if (alg == "none") != (parts[2] == "") {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a JWT",
+		}
+	}
+
+	if !exp && !nbf {
+		return nil
+	}
+
+	now := data.Options.Time()
+	if exp {
+		t, ok, err := numericDate(claims, "exp")
+		if err != nil || !ok {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "must have a valid exp claim",
+			}
+		}
+		if !now.Before(t.Add(skew)) {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        fmt.Sprintf("expired at %s", t.UTC().Format(time.RFC3339)),
+			}
+		}
+	}
+
+	if nbf {
+		t, ok, err := numericDate(claims, "nbf")
+		if err != nil {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "must have a valid nbf claim",
+			}
+		}
+		if ok && now.Add(skew).Before(t) {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        fmt.Sprintf("is not valid before %s", t.UTC().Format(time.RFC3339)),
+			}
+		}
+	}
+
+	return nil
+}
+
+// Decodes a base64url segment of a JWT holding a JSON object into v
+func decodeJSON(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.Strict().DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || b[0] != '{' {
+		return fmt.Errorf("not a JSON object")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return fmt.Errorf("trailing data after JSON object")
+	}
+	return nil
+}
+
+// Returns the NumericDate claim with the given name: seconds since the Unix
+// epoch, which may have a fraction. ok is false if there's no such claim.
+func numericDate(claims map[string]interface{}, name string) (t time.Time, ok bool, err error) {
+	claim, found := claims[name]
+	if !found {
+		return t, false, nil
+	}
+
+	n, isNumber := claim.(json.Number)
+	if !isNumber {
+		return t, false, fmt.Errorf("%s is not a number", name)
+	}
+
+	f, err := n.Float64()
+	if err != nil || math.IsInf(f, 0) || math.Abs(f) > math.MaxInt64/1e9 {
+		return t, false, fmt.Errorf("%s is not a valid date", name)
+	}
+
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9)), true, nil
+}
@@ -0,0 +1,96 @@
+// Package token contains rules for the format of tokens and keys: JSON Web
+// Tokens, prefixed API keys and hex encoded digests.
+//
+// These only check a token's format. In particular JWT never verifies a
+// token's signature, so passing validation doesn't mean a token can be
+// trusted.
+package token
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("JWT", JWT)
+	rules.Add("APIKey", APIKey)
+	rules.Add("MD5", MD5)
+	rules.Add("SHA1", SHA1)
+	rules.Add("SHA256", SHA256)
+	rules.Add("SHA512", SHA512)
+}
+
+// Passes if the data is an API key starting with one of the prefixes in the
+// tag followed by letters and digits, eg. 'APIKey:sk_live_|sk_test_'. An
+// optional second argument gives the number of characters after the prefix,
+// eg. 'APIKey:sk_live_|sk_test_:24'.
+func APIKey(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	args := data.SplitArgs()
+	if len(args) == 0 || args[0] == "" {
+		return fmt.Errorf("No prefixes found in the validation struct (eg 'APIKey:sk_live_|sk_test_')")
+	}
+	if len(args) > 2 {
+		return fmt.Errorf("Too many APIKey arguments '%s': expected prefixes and a length", data.Args[0])
+	}
+
+	size := 0
+	if len(args) == 2 {
+		if size, err = strconv.Atoi(args[1]); err != nil || size < 1 {
+			return fmt.Errorf("Invalid APIKey length '%s'", args[1])
+		}
+	}
+
+	prefixes := strings.Split(args[0], "|")
+	rest, found := "", false
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(v, prefix) {
+			rest, found = v[len(prefix):], true
+			break
+		}
+	}
+
+	if !found {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must start with one of %s", strings.Join(prefixes, ", ")),
+		}
+	}
+
+	if rest == "" || !isAlphanumeric(rest) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid API key",
+		}
+	}
+
+	if size > 0 && len(rest) != size {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("must have %d characters after its prefix", size),
+		}
+	}
+
+	return nil
+}
+
+// Reports whether s only contains ASCII letters and digits
+func isAlphanumeric(s string) bool {
+	for _, c := range s {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
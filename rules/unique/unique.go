@@ -0,0 +1,126 @@
+package unique
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+func init() {
+	rules.Add("Unique", Unique)
+}
+
+// Checks that every item in a slice, array or map (by value) is unique. Items
+// are compared with == if they're comparable, or reflect.DeepEqual if not.
+//
+// For collections of structs (or pointers to structs) you can compare items by
+// one of their fields instead: 'Unique:ID' passes if no two items have the
+// same ID. Nil items have no field to compare, so they're skipped.
+func Unique(data rules.ValidationData) error {
+	value := reflect.ValueOf(data.Value)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a slice, array or map",
+		}
+	}
+
+	var items []reflect.Value
+	if value.Kind() == reflect.Map {
+		for _, k := range value.MapKeys() {
+			items = append(items, value.MapIndex(k))
+		}
+	} else {
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, value.Index(i))
+		}
+	}
+
+	var key string
+	if len(data.Args) > 0 {
+		key = data.Args[0]
+	}
+
+	var (
+		seen   = map[interface{}]struct{}{}
+		others []interface{}
+	)
+	for i, item := range items {
+		v, ok, err := itemKey(item, key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		duplicate := false
+		// A struct or array type may be comparable while holding interfaces
+		// with values which aren't, so check the value itself.
+		if v != nil && reflect.ValueOf(v).Comparable() {
+			_, duplicate = seen[v]
+			seen[v] = struct{}{}
+		} else {
+			for _, other := range others {
+				if reflect.DeepEqual(v, other) {
+					duplicate = true
+					break
+				}
+			}
+			others = append(others, v)
+		}
+
+		if !duplicate {
+			continue
+		}
+
+		failure := fmt.Sprintf("contains a duplicate item at index %d", i)
+		if value.Kind() == reflect.Map {
+			failure = "contains duplicate values"
+		}
+		if key != "" {
+			failure = fmt.Sprintf("contains more than one item with the %s %v", key, v)
+		}
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        failure,
+		}
+	}
+
+	return nil
+}
+
+// Returns the value to compare an item by: either the item itself or the named
+// field of a struct. ok is false for a nil item when comparing by field.
+func itemKey(item reflect.Value, key string) (v interface{}, ok bool, err error) {
+	// Items in a []interface{} are wrapped in an interface value
+	if item.Kind() == reflect.Interface {
+		item = item.Elem()
+	}
+
+	if key == "" {
+		if !item.IsValid() {
+			return nil, true, nil
+		}
+		return item.Interface(), true, nil
+	}
+
+	if !item.IsValid() || item.Kind() == reflect.Ptr && item.IsNil() {
+		return nil, false, nil
+	}
+	if item.Kind() == reflect.Ptr {
+		item = item.Elem()
+	}
+	if item.Kind() != reflect.Struct {
+		return nil, false, fmt.Errorf("Unique:%s can only be used with collections of structs", key)
+	}
+
+	field := item.FieldByName(key)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil, false, fmt.Errorf("Unique:%s: field %s does not exist or is unexported", key, key)
+	}
+	return field.Interface(), true, nil
+}
@@ -0,0 +1,211 @@
+package url
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/tonyhb/govalidate/helper"
+	"github.com/tonyhb/govalidate/rules"
+)
+
+// Passes if the data is an absolute URI following the generic syntax of
+// RFC 3986 section 3, such as "urn:isbn:0451450523", "mailto:a@example.com"
+// or "s3://bucket/key". Unlike URL this accepts any scheme and doesn't require
+// a host.
+func URI(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !IsURI(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid URI",
+		}
+	}
+
+	return nil
+}
+
+// Passes if the data is a URI or a relative reference, such as "../a?b#c",
+// following RFC 3986 section 4.1.
+func URIReference(data rules.ValidationData) error {
+	v, err := helper.ToString(data.Value)
+	if err != nil {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a string",
+		}
+	}
+
+	if !IsURIReference(v) {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        "is not a valid URI reference",
+		}
+	}
+
+	return nil
+}
+
+// Reports whether str is an absolute URI as defined by RFC 3986:
+//
+//	URI = scheme ":" hier-part [ "?" query ] [ "#" fragment ]
+func IsURI(str string) bool {
+	i := strings.IndexByte(str, ':')
+	if i < 0 || !isScheme(str[:i]) {
+		return false
+	}
+	return isRelativeRef(str[i+1:], true)
+}
+
+// Reports whether str is a URI or a relative reference as defined by RFC 3986.
+func IsURIReference(str string) bool {
+	return IsURI(str) || isRelativeRef(str, false)
+}
+
+// Checks the part of a URI after the scheme, or a relative reference:
+//
+//	hier-part     = "//" authority path-abempty / path-absolute / path-rootless / path-empty
+//	relative-part = "//" authority path-abempty / path-absolute / path-noscheme / path-empty
+func isRelativeRef(str string, hasScheme bool) bool {
+	if i := strings.IndexByte(str, '#'); i >= 0 {
+		if !isChars(str[i+1:], "/?") {
+			return false
+		}
+		str = str[:i]
+	}
+	if i := strings.IndexByte(str, '?'); i >= 0 {
+		if !isChars(str[i+1:], "/?") {
+			return false
+		}
+		str = str[:i]
+	}
+
+	path := str
+	if strings.HasPrefix(str, "//") {
+		authority := str[2:]
+		path = ""
+		if i := strings.IndexByte(authority, '/'); i >= 0 {
+			authority, path = authority[:i], authority[i:]
+		}
+		if !isAuthority(authority) {
+			return false
+		}
+	} else if !hasScheme {
+		// path-noscheme: the first segment can't contain a colon, otherwise
+		// it would be parsed as a scheme
+		first := path
+		if i := strings.IndexByte(path, '/'); i >= 0 {
+			first = path[:i]
+		}
+		if strings.IndexByte(first, ':') >= 0 {
+			return false
+		}
+	}
+
+	return isChars(path, "/")
+}
+
+// authority = [ userinfo "@" ] host [ ":" port ]
+func isAuthority(str string) bool {
+	if i := strings.LastIndexByte(str, '@'); i >= 0 {
+		userinfo := str[:i]
+		if strings.IndexByte(userinfo, '@') >= 0 || !isChars(userinfo, "") {
+			return false
+		}
+		str = str[i+1:]
+	}
+
+	host, port := str, ""
+	if strings.HasPrefix(str, "[") {
+		end := strings.IndexByte(str, ']')
+		if end < 0 || !isIPLiteral(str[1:end]) {
+			return false
+		}
+		host, port = "", str[end+1:]
+		if port != "" {
+			if port[0] != ':' {
+				return false
+			}
+			port = port[1:]
+		}
+	} else if i := strings.LastIndexByte(str, ':'); i >= 0 {
+		host, port = str[:i], str[i+1:]
+	}
+
+	for i := 0; i < len(port); i++ {
+		if !isDigit(port[i]) {
+			return false
+		}
+	}
+
+	// reg-name = *( unreserved / pct-encoded / sub-delims ), which includes
+	// IPv4 addresses
+	return !strings.ContainsAny(host, ":@") && isChars(host, "")
+}
+
+// IP-literal = "[" ( IPv6address / IPvFuture ) "]"
+func isIPLiteral(str string) bool {
+	if len(str) > 1 && (str[0] == 'v' || str[0] == 'V') {
+		// IPvFuture = "v" 1*HEXDIG "." 1*( unreserved / sub-delims / ":" )
+		i := strings.IndexByte(str, '.')
+		if i < 2 || i == len(str)-1 || strings.Trim(str[1:i], "0123456789abcdefABCDEF") != "" {
+			return false
+		}
+		return isChars(str[i+1:], "") && !strings.ContainsAny(str[i+1:], "%@")
+	}
+
+	addr, err := netip.ParseAddr(str)
+	return err == nil && addr.Is6() && addr.Zone() == ""
+}
+
+// scheme = ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+func isScheme(str string) bool {
+	if str == "" || !isAlpha(str[0]) {
+		return false
+	}
+	for i := 1; i < len(str); i++ {
+		c := str[i]
+		if !isAlpha(c) && !isDigit(c) && c != '+' && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// Reports whether str only contains pchars (unreserved, percent encoded,
+// sub-delims, ":" and "@") and any extra characters allowed.
+func isChars(str, extra string) bool {
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case isAlpha(c) || isDigit(c) || strings.IndexByte("-._~!$&'()*+,;=:@", c) >= 0:
+		case strings.IndexByte(extra, c) >= 0:
+		case c == '%':
+			if i+2 >= len(str) || !isHex(str[i+1]) || !isHex(str[i+2]) {
+				return false
+			}
+			i += 2
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHex(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
@@ -2,7 +2,9 @@ package url
 
 import (
 	"fmt"
+	"net"
 	"net/url"
+	"path"
 	"strings"
 
 	"github.com/tonyhb/govalidate/helper"
@@ -11,12 +13,39 @@ import (
 
 func init() {
 	rules.Add("URL", URL)
+	rules.Add("URI", URI)
+	rules.Add("URIReference", URIReference)
+}
+
+// Schemes which always use TLS, accepted by 'URL:tls'
+var secureSchemes = map[string]struct{}{
+	"https":  {},
+	"wss":    {},
+	"ftps":   {},
+	"ldaps":  {},
+	"imaps":  {},
+	"pop3s":  {},
+	"smtps":  {},
+	"rediss": {},
 }
 
 // Validates a URL using url.Parse() in the net/url library.
 // For a valid URL, the following need to be present in a parsed URL:
-// * Scheme (either http or https)
-// * Host (without a backslash)
+// * Scheme (by default either http or https)
+// * Host (a valid host name or IP address)
+//
+// The following arguments can be combined, eg.
+// 'URL:schemes=postgres|mysql:nouserinfo':
+//
+//	schemes=a|b    allows the listed schemes instead of http and https;
+//	               'schemes=*' allows any scheme
+//	tls            requires a scheme which uses TLS, such as https or wss
+//	hosts=a|*.b    only allows the listed hosts; '*.' matches any subdomain
+//	ports=80|443   only allows the listed ports if a port is given
+//	paths=/a|/b    requires the path to be one of the paths or below it
+//	nouserinfo     rejects URLs with credentials, such as http://user:pw@host
+//	relative       also allows relative URLs such as "/path?query", which
+//	               skip the scheme and host checks
 func URL(data rules.ValidationData) error {
 	v, err := helper.ToString(data.Value)
 	if err != nil {
@@ -26,6 +55,11 @@ func URL(data rules.ValidationData) error {
 		}
 	}
 
+	opts, err := parseOptions(data.SplitArgs())
+	if err != nil {
+		return err
+	}
+
 	parsed, err := url.Parse(v)
 	if err != nil {
 		return rules.ErrInvalid{
@@ -34,19 +68,133 @@ func URL(data rules.ValidationData) error {
 		}
 	}
 
-	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+	if failure := opts.check(parsed); failure != "" {
 		return rules.ErrInvalid{
 			ValidationData: data,
-			Failure:        fmt.Sprintf("has an invalid scheme '%s'", parsed.Scheme),
+			Failure:        failure,
 		}
 	}
 
-	if parsed.Host == "" || strings.IndexRune(parsed.Host, '\\') > 0 {
-		return rules.ErrInvalid{
-			ValidationData: data,
-			Failure:        fmt.Sprintf("has an invalid host ('%s')", parsed.Host),
+	return nil
+}
+
+type options struct {
+	schemes    []string
+	hosts      []string
+	ports      []string
+	paths      []string
+	tls        bool
+	nouserinfo bool
+	relative   bool
+}
+
+func parseOptions(args []string) (opts options, err error) {
+	opts.schemes = []string{"http", "https"}
+
+	for _, arg := range args {
+		key, value := arg, ""
+		if i := strings.Index(arg, "="); i >= 0 {
+			key, value = arg[:i], arg[i+1:]
+		}
+
+		switch key {
+		case "schemes":
+			opts.schemes = strings.Split(strings.ToLower(value), "|")
+		case "hosts":
+			opts.hosts = strings.Split(strings.ToLower(value), "|")
+		case "ports":
+			opts.ports = strings.Split(value, "|")
+		case "paths":
+			opts.paths = strings.Split(value, "|")
+		case "tls":
+			opts.tls = true
+		case "nouserinfo":
+			opts.nouserinfo = true
+		case "relative":
+			opts.relative = true
+		default:
+			return opts, fmt.Errorf("Unknown URL argument '%s' (eg 'URL:schemes=http|https:nouserinfo')", arg)
 		}
 	}
 
-	return nil
+	return opts, nil
+}
+
+// Returns a failure message if the URL doesn't meet the options.
+func (opts options) check(parsed *url.URL) string {
+	relative := parsed.Scheme == "" && parsed.Host == "" && parsed.Opaque == ""
+	if relative && !opts.relative {
+		return fmt.Sprintf("has an invalid scheme '%s'", parsed.Scheme)
+	}
+
+	if !relative {
+		if !contains(opts.schemes, parsed.Scheme) && !contains(opts.schemes, "*") {
+			return fmt.Sprintf("has an invalid scheme '%s'", parsed.Scheme)
+		}
+
+		if _, ok := secureSchemes[parsed.Scheme]; opts.tls && !ok {
+			return fmt.Sprintf("has an insecure scheme '%s'", parsed.Scheme)
+		}
+
+		if !isHost(parsed.Hostname()) || strings.IndexRune(parsed.Host, '\\') > 0 {
+			return fmt.Sprintf("has an invalid host ('%s')", parsed.Host)
+		}
+
+		if len(opts.hosts) > 0 && !matchHost(opts.hosts, parsed.Hostname()) {
+			return fmt.Sprintf("has a host which isn't allowed ('%s')", parsed.Hostname())
+		}
+
+		if port := parsed.Port(); port != "" && len(opts.ports) > 0 && !contains(opts.ports, port) {
+			return fmt.Sprintf("has a port which isn't allowed ('%s')", port)
+		}
+
+		if parsed.User != nil && opts.nouserinfo {
+			return "must not contain a username or password"
+		}
+	}
+
+	if len(opts.paths) > 0 {
+		// Resolve dot segments first so "/api/../admin" can't pass as "/api"
+		p := path.Clean(parsed.Path)
+		for _, prefix := range opts.paths {
+			dir := strings.TrimSuffix(prefix, "/")
+			if p == prefix || p == dir || strings.HasPrefix(p, dir+"/") {
+				return ""
+			}
+		}
+		return fmt.Sprintf("has a path which isn't allowed ('%s')", parsed.Path)
+	}
+
+	return ""
+}
+
+// Reports whether host is an IP address or a (possibly internationalized)
+// host name.
+func isHost(host string) bool {
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	ascii, err := helper.ToASCIIHostname(host)
+	return err == nil && helper.IsHostname(ascii)
+}
+
+// Reports whether host matches any of the allowed hosts, where "*.example.com"
+// matches any subdomain of example.com.
+func matchHost(allowed []string, host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, a := range allowed {
+		if a == host || (strings.HasPrefix(a, "*.") && strings.HasSuffix(host, a[1:])) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
 }
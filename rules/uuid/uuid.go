@@ -1,7 +1,10 @@
 package uuid
 
 import (
-	"regexp"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/tonyhb/govalidate/helper"
 	"github.com/tonyhb/govalidate/rules"
@@ -11,33 +14,137 @@ func init() {
 	rules.Add("UUID", UUID)
 }
 
-// Used to check whether a string has at most N characters
-// Fails if data is a string and its length is more than the specified comparator. Passes in all other cases.
+// Validates that the data is a UUID as defined by RFC 9562. This accepts
+// strings in either case, in the canonical form
+// "8563d95d-efb0-4e87-95d8-1d6c5debf298", optionally wrapped in braces or
+// prefixed with "urn:uuid:", and [16]byte-backed UUID types.
+//
+// By default any version from 1 to 8 is allowed and the variant bits must be
+// those of RFC 9562. The following arguments can be combined, eg.
+// 'UUID:v4|v7:strict':
+//
+//	v1|v4|...  only allows the listed versions (v1 to v8); 'nil' and 'max'
+//	           allow the nil (all zeros) and max (all ones) UUIDs
+//	strict     only accepts the canonical 36 character form
 func UUID(data rules.ValidationData) error {
-	v, err := helper.ToString(data.Value)
+	versions, strict, err := parseArgs(data.SplitArgs())
 	if err != nil {
+		return err
+	}
+
+	b, ok := toBytes(data.Value, strict)
+	if !ok {
 		return rules.ErrInvalid{
 			ValidationData: data,
-			Failure:        "is not a string",
+			Failure:        "is an invalid UUID",
+		}
+	}
+
+	switch {
+	case b == [16]byte{}:
+		if !versions["nil"] {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "is the nil UUID",
+			}
+		}
+		return nil
+	case b == maxUUID:
+		if !versions["max"] {
+			return rules.ErrInvalid{
+				ValidationData: data,
+				Failure:        "is the max UUID",
+			}
 		}
+		return nil
 	}
 
-	if !IsUUID(v) {
+	// The variant is held in the top bits of octet 8, and must be 10xx
+	if b[8]&0xc0 != 0x80 {
 		return rules.ErrInvalid{
 			ValidationData: data,
-			Failure:        "is an invalid UUID",
+			Failure:        "is an invalid UUID; it doesn't use the RFC 9562 variant",
+		}
+	}
+
+	version := fmt.Sprintf("v%d", b[6]>>4)
+	if !versions[version] {
+		return rules.ErrInvalid{
+			ValidationData: data,
+			Failure:        fmt.Sprintf("is a %s UUID, which isn't allowed", version),
 		}
 	}
 
 	return nil
 }
 
-func IsUUID(uuid string) bool {
-	var hexPattern = "^(urn\\:uuid\\:)?\\{?([a-z0-9]{8})-([a-z0-9]{4})-([1-5][a-z0-9]{3})-([a-z0-9]{4})-([a-z0-9]{12})\\}?$"
-	re := regexp.MustCompile(hexPattern)
+var maxUUID = [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+func parseArgs(args []string) (versions map[string]bool, strict bool, err error) {
+	versions = map[string]bool{}
 
-	if match := re.FindStringSubmatch(uuid); match == nil {
-		return false
+	for _, arg := range args {
+		if arg == "strict" {
+			strict = true
+			continue
+		}
+
+		for _, v := range strings.Split(arg, "|") {
+			switch v {
+			case "v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "nil", "max":
+				versions[v] = true
+			default:
+				return nil, false, fmt.Errorf("Unknown UUID argument '%s' (eg 'UUID:v4|v7:strict')", v)
+			}
+		}
 	}
-	return true
+
+	if len(versions) == 0 {
+		for i := 1; i <= 8; i++ {
+			versions[fmt.Sprintf("v%d", i)] = true
+		}
+	}
+
+	return versions, strict, nil
+}
+
+// Converts a [16]byte-backed type or the string form of a UUID into bytes.
+func toBytes(data interface{}, strict bool) (b [16]byte, ok bool) {
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Array && value.Len() == 16 && value.Type().Elem().Kind() == reflect.Uint8 {
+		reflect.Copy(reflect.ValueOf(&b).Elem(), value)
+		return b, true
+	}
+
+	str, err := helper.ToString(data)
+	if err != nil {
+		return b, false
+	}
+	return parse(str, strict)
+}
+
+func parse(str string, strict bool) (b [16]byte, ok bool) {
+	if !strict {
+		if len(str) > 9 && strings.EqualFold(str[:9], "urn:uuid:") {
+			str = str[9:]
+		} else if strings.HasPrefix(str, "{") && strings.HasSuffix(str, "}") {
+			str = str[1 : len(str)-1]
+		}
+	}
+
+	if len(str) != 36 || str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+		return b, false
+	}
+
+	hexStr := str[0:8] + str[9:13] + str[14:18] + str[19:23] + str[24:]
+	if _, err := hex.Decode(b[:], []byte(hexStr)); err != nil {
+		return b, false
+	}
+	return b, true
+}
+
+// Reports whether uuid is the string form of an RFC 9562 UUID of any version.
+func IsUUID(uuid string) bool {
+	b, ok := parse(uuid, false)
+	return ok && b[8]&0xc0 == 0x80 && b[6]>>4 >= 1 && b[6]>>4 <= 8
 }
@@ -0,0 +1,59 @@
+package uuid
+
+import (
+	"testing"
+
+	"github.com/tonyhb/govalidate/rules"
+)
+
+type testUUID [16]byte
+
+func TestUUIDOptions(t *testing.T) {
+	var (
+		v1     = "fb623672-40dd-11e3-91ea-ce3f5508acd9"
+		v4     = "8563d95d-efb0-4e87-95d8-1d6c5debf298"
+		v7     = "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+		v8     = "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0"
+		nilStr = "00000000-0000-0000-0000-000000000000"
+		maxStr = "FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF"
+	)
+
+	var tests = []struct {
+		Args    []string
+		Valid   []interface{}
+		Invalid []interface{}
+	}{
+		{nil, []interface{}{v1, v4, v7, v8, testUUID{0: 1, 6: 0x40, 8: 0x80}}, []interface{}{nilStr, maxStr, testUUID{}}},
+		{[]string{"v4"}, []interface{}{v4, "{" + v4 + "}"}, []interface{}{v1, v7}},
+		{[]string{"v4|v7"}, []interface{}{v4, v7}, []interface{}{v1, v8}},
+		{[]string{"v7|nil|max"}, []interface{}{v7, nilStr, maxStr, testUUID{}}, []interface{}{v4}},
+		{[]string{"strict"}, []interface{}{v4, "8563D95D-EFB0-4E87-95D8-1D6C5DEBF298"}, []interface{}{"urn:uuid:" + v4, "{" + v4 + "}", "8563d95defb04e8795d81d6c5debf298"}},
+		{[]string{"v4:strict"}, []interface{}{v4}, []interface{}{v7, "{" + v4 + "}"}},
+	}
+
+	for _, test := range tests {
+		object := rules.ValidationData{
+			Field: "Test",
+			Args:  test.Args,
+		}
+
+		for _, v := range test.Valid {
+			object.Value = v
+			if err := UUID(object); err != nil {
+				t.Errorf("Unexpected error with valid UUID%v value %v: %s", test.Args, v, err)
+			}
+		}
+
+		for _, v := range test.Invalid {
+			object.Value = v
+			if err := UUID(object); err == nil {
+				t.Errorf("Expected invalid UUID%v value %v to fail validation", test.Args, v)
+			}
+		}
+	}
+
+	object := rules.ValidationData{Field: "Test", Value: v4, Args: []string{"v9"}}
+	if _, ok := UUID(object).(rules.ErrInvalid); ok {
+		t.Errorf("Expected unknown versions to return a non-validation error")
+	}
+}
@@ -4,28 +4,72 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/tonyhb/govalidate/rules"
 	_ "github.com/tonyhb/govalidate/rules/alpha"
 	_ "github.com/tonyhb/govalidate/rules/alphanumeric"
+	_ "github.com/tonyhb/govalidate/rules/between"
+	_ "github.com/tonyhb/govalidate/rules/domain"
 	_ "github.com/tonyhb/govalidate/rules/email"
+	_ "github.com/tonyhb/govalidate/rules/encoding"
+	_ "github.com/tonyhb/govalidate/rules/finance"
+	_ "github.com/tonyhb/govalidate/rules/geo"
 	_ "github.com/tonyhb/govalidate/rules/greaterthan"
+	_ "github.com/tonyhb/govalidate/rules/greaterthanorequal"
+	_ "github.com/tonyhb/govalidate/rules/ids"
+	_ "github.com/tonyhb/govalidate/rules/iso"
+	_ "github.com/tonyhb/govalidate/rules/itemcount"
 	_ "github.com/tonyhb/govalidate/rules/length"
 	_ "github.com/tonyhb/govalidate/rules/lessthan"
+	_ "github.com/tonyhb/govalidate/rules/lessthanorequal"
+	_ "github.com/tonyhb/govalidate/rules/maxitems"
 	_ "github.com/tonyhb/govalidate/rules/maxlength"
+	_ "github.com/tonyhb/govalidate/rules/minitems"
 	_ "github.com/tonyhb/govalidate/rules/minlength"
+	_ "github.com/tonyhb/govalidate/rules/network"
 	_ "github.com/tonyhb/govalidate/rules/notempty"
 	_ "github.com/tonyhb/govalidate/rules/notzero"
 	_ "github.com/tonyhb/govalidate/rules/notzerotime"
+	_ "github.com/tonyhb/govalidate/rules/oneof"
+	_ "github.com/tonyhb/govalidate/rules/password"
+	_ "github.com/tonyhb/govalidate/rules/path"
+	_ "github.com/tonyhb/govalidate/rules/phone"
+	_ "github.com/tonyhb/govalidate/rules/postalcode"
 	_ "github.com/tonyhb/govalidate/rules/regexp"
+	_ "github.com/tonyhb/govalidate/rules/semver"
+	_ "github.com/tonyhb/govalidate/rules/strings"
+	_ "github.com/tonyhb/govalidate/rules/timeformat"
+	_ "github.com/tonyhb/govalidate/rules/times"
+	_ "github.com/tonyhb/govalidate/rules/token"
+	_ "github.com/tonyhb/govalidate/rules/unique"
 	_ "github.com/tonyhb/govalidate/rules/url"
 	_ "github.com/tonyhb/govalidate/rules/uuid"
 )
 
+// A Validator runs validation rules with a set of options which apply to every
+// rule, such as the unit used to count string lengths:
+//
+//	v := validate.Validator{}
+//	v.LengthUnit = rules.Graphemes
+//	err := v.Run(page)
+//
+// Arguments in a tag always take precedence over the validator's options.
+type Validator struct {
+	rules.Options
+}
+
 // Takes a struct, loops through all fields and calls check on any fields that
 // have a validate tag. If the field is an anonymous struct recursively run
 // validation on it.
+//
+// This uses a Validator with the default options.
 func Run(object interface{}, fieldsSlice ...string) error {
+	return Validator{}.Run(object, fieldsSlice...)
+}
+
+// Validates the struct using the validator's options. See Run.
+func (v Validator) Run(object interface{}, fieldsSlice ...string) error {
 	pass := true // We'll override this if checking returns false
 	err := ValidationError{}
 
@@ -52,7 +96,7 @@ func Run(object interface{}, fieldsSlice ...string) error {
 
 		// Is this an anonymous struct? If so, we also need to validate on this.
 		if typ.Field(i).Anonymous == true {
-			if anonErr := Run(value.Field(i).Interface(), fieldsSlice...); anonErr != nil {
+			if anonErr := v.Run(value.Field(i).Interface(), fieldsSlice...); anonErr != nil {
 				// The validation failed: set pass to false and merge the anonymous struct's
 				// validation errors with our current validation error above to give a complete
 				// error message.
@@ -80,7 +124,7 @@ func Run(object interface{}, fieldsSlice ...string) error {
 		}
 
 		// Validate this particular field against the options in our tag
-		if validateError = validateField(value.Field(i).Interface(), typ.Field(i).Name, validateTag); validateError == nil {
+		if validateError = v.validateField(value, value.Field(i).Interface(), typ.Field(i).Name, validateTag); validateError == nil {
 			continue
 		}
 
@@ -91,7 +135,7 @@ func Run(object interface{}, fieldsSlice ...string) error {
 		}
 
 		pass = false
-		err.addFailure(typ.Field(i).Name, validateError.Error())
+		err.addFailure(typ.Field(i).Name, validateError)
 	}
 
 	if pass {
@@ -104,15 +148,16 @@ func Run(object interface{}, fieldsSlice ...string) error {
 var rxRegexp = regexp.MustCompile(`Regexp:\/.+/`)
 
 // Takes a field's value and the validation tag and applies each check
-// until either a test fails or all tests pass.
-func validateField(data interface{}, fieldName, tag string) (err error) {
+// until either a test fails or all tests pass. parent is the struct holding
+// the field, which rules use to compare against other fields.
+func (v Validator) validateField(parent reflect.Value, data interface{}, fieldName, tag string) (err error) {
 	// A tag can specify multiple validation rules which are delimited via ','.
 	// However, because we allow regular expressions we can't split the tag field
 	// via all commas to find our validation rules: we need to extract the regular expression
 	// first (in case it specifies a comma), and *then* run through our validation rules.
 	if match := rxRegexp.FindString(tag); match != "" {
 		// If we fail validating the regexp we can break here
-		if err := validateRule(data, fieldName, match); err != nil {
+		if err := v.validateRule(parent, data, fieldName, match); err != nil {
 			return err
 		}
 		// Now we need to replace our regular expression from the tag list to continue
@@ -123,12 +168,12 @@ func validateField(data interface{}, fieldName, tag string) (err error) {
 	for tag != "" {
 		var next string
 
-		i := strings.Index(tag, ",")
+		i := ruleSeparator(tag)
 		if i >= 0 {
 			tag, next = tag[:i], tag[i+1:]
 		}
 
-		if err := validateRule(data, fieldName, tag); err != nil {
+		if err := v.validateRule(parent, data, fieldName, tag); err != nil {
 			return err
 		}
 
@@ -141,7 +186,7 @@ func validateField(data interface{}, fieldName, tag string) (err error) {
 
 // Given a validation rule from a tag, run the associated validation methods and return
 // the result.
-func validateRule(data interface{}, fieldName, rule string) error {
+func (v Validator) validateRule(parent reflect.Value, data interface{}, fieldName, rule string) error {
 	var args []string
 
 	// Remove any preceeding spaces from comma separated tags
@@ -172,10 +217,53 @@ func validateRule(data interface{}, fieldName, rule string) error {
 		return err
 	} else {
 		var data = rules.ValidationData{
-			Field: fieldName,
-			Value: data,
-			Args:  args,
+			Field:   fieldName,
+			Value:   data,
+			Args:    args,
+			Options: v.Options,
+			Struct:  parent,
 		}
 		return method(data)
 	}
 }
+
+// Returns the index of the comma separating the first rule in a tag from the
+// next, or -1 if there's only one rule. Rule arguments may contain commas, so a
+// comma only separates rules if it's outside of brackets and quotes and is
+// followed by another rule name (which always starts with a letter) or an
+// empty rule:
+//
+//	`validate:"Between:1,10, NotZero"`
+//	`validate:"Between:(0,1], NotZero"`
+//	`validate:"Contains:', Inc', NotEmpty"`
+//
+// An argument is only quoted if the quote directly follows a colon, so
+// apostrophes elsewhere in arguments are left alone.
+func ruleSeparator(tag string) int {
+	depth := 0
+	quoted := false
+	for i, c := range tag {
+		if quoted {
+			quoted = c != '\''
+			continue
+		}
+
+		switch c {
+		case '\'':
+			quoted = i > 0 && tag[i-1] == ':'
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ',':
+			if depth > 0 {
+				continue
+			}
+			next := strings.TrimLeft(tag[i+1:], " ")
+			if next == "" || next[0] == ',' || unicode.IsLetter(rune(next[0])) {
+				return i
+			}
+		}
+	}
+	return -1
+}